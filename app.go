@@ -211,6 +211,8 @@ type EMoneyApp struct {
 	mm *module.Manager
 
 	// simulation manager
+	sm *module.SimulationManager
+
 	configurator module.Configurator
 }
 
@@ -219,7 +221,7 @@ func (app *EMoneyApp) LegacyAmino() *codec.LegacyAmino {
 }
 
 func (app *EMoneyApp) SimulationManager() *module.SimulationManager {
-	panic("not supported")
+	return app.sm
 }
 
 type GenesisState map[string]json.RawMessage
@@ -410,8 +412,8 @@ func NewApp(
 	app.inflationKeeper = inflation.NewKeeper(app.appCodec, keys[inflation.StoreKey], app.bankKeeper, app.accountKeeper, app.stakingKeeper, buyback.AccountName, authtypes.FeeCollectorName)
 	app.lpKeeper = liquidityprovider.NewKeeper(app.appCodec, keys[lptypes.StoreKey], app.bankKeeper)
 	app.issuerKeeper = issuer.NewKeeper(app.appCodec, keys[issuer.StoreKey], app.lpKeeper, app.inflationKeeper, app.bankKeeper)
-	app.authorityKeeper = authority.NewKeeper(app.appCodec, keys[authority.StoreKey], app.issuerKeeper, app.bankKeeper, app, &app.upgradeKeeper, app.paramsKeeper)
-	app.marketKeeper = market.NewKeeper(app.appCodec, keys[market.StoreKey], keys[market.StoreKeyIdx], app.accountKeeper, app.bankKeeper)
+	app.marketKeeper = market.NewKeeper(app.appCodec, keys[market.StoreKey], keys[market.StoreKeyIdx], app.accountKeeper, app.bankKeeper, app.GetSubspace(market.ModuleName), buyback.AccountName)
+	app.authorityKeeper = authority.NewKeeper(app.appCodec, keys[authority.StoreKey], app.issuerKeeper, app.bankKeeper, app, &app.upgradeKeeper, app.paramsKeeper, app.marketKeeper)
 	app.buybackKeeper = buyback.NewKeeper(app.appCodec, keys[buyback.StoreKey], app.marketKeeper, app.accountKeeper, app.stakingKeeper, app.bankKeeper)
 
 	// NOTE: we may consider parsing `appOpts` inside module constructors. For the moment
@@ -440,12 +442,12 @@ func NewApp(
 		params.NewAppModule(app.paramsKeeper),
 		transferModule,
 		emdistr.NewAppModule(distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper), app.distrKeeper, app.accountKeeper, app.bankKeeper, app.database),
-		liquidityprovider.NewAppModule(app.lpKeeper),
-		issuer.NewAppModule(app.issuerKeeper),
-		authority.NewAppModule(app.authorityKeeper),
-		market.NewAppModule(app.marketKeeper),
-		buyback.NewAppModule(app.buybackKeeper, app.bankKeeper),
-		inflation.NewAppModule(app.inflationKeeper),
+		liquidityprovider.NewAppModule(appCodec, app.lpKeeper, app.accountKeeper, app.bankKeeper),
+		issuer.NewAppModule(appCodec, app.issuerKeeper, app.accountKeeper, app.bankKeeper, app.lpKeeper),
+		authority.NewAppModule(appCodec, app.authorityKeeper, app.accountKeeper, app.bankKeeper, app.issuerKeeper),
+		market.NewAppModule(appCodec, app.marketKeeper, app.accountKeeper, app.bankKeeper),
+		buyback.NewAppModule(appCodec, app.buybackKeeper, app.bankKeeper),
+		inflation.NewAppModule(appCodec, app.inflationKeeper),
 		queries.NewAppModule(app.accountKeeper, app.bankKeeper, app.slashingKeeper),
 	)
 
@@ -528,16 +530,42 @@ func NewApp(
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter(), encodingConfig.Amino)
 
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
-	// TODO hack to initialize application
-	// from sdk.bank/module.go: AppModule RegisterServices
-	// 	m := keeper.NewMigrator(am.keeper.(keeper.BaseKeeper))
-	sdkBk := app.bankKeeper.GetBankKeeper()
-	bankMigModule := bank.NewAppModule(appCodec, *sdkBk, app.accountKeeper)
-	proxy := app.mm.Modules[banktypes.ModuleName]
-	app.mm.Modules[banktypes.ModuleName] = bankMigModule
+	// The bank module's RegisterServices requires a bankkeeper.BaseKeeper for its migrations, which the proxy keeper
+	// is not. Its services are registered here instead, so that bank messages are still routed through the proxy
+	// keeper and notify its balance listeners.
+	bankModule := app.mm.Modules[banktypes.ModuleName]
+	delete(app.mm.Modules, banktypes.ModuleName)
 	app.mm.RegisterServices(app.configurator)
+	app.mm.Modules[banktypes.ModuleName] = bankModule
+
+	banktypes.RegisterMsgServer(app.configurator.MsgServer(), bankkeeper.NewMsgServerImpl(app.bankKeeper))
+	banktypes.RegisterQueryServer(app.configurator.QueryServer(), app.bankKeeper)
+	bankMigrator := bankkeeper.NewMigrator((*app.bankKeeper.GetBankKeeper()).(bankkeeper.BaseKeeper))
+	if err := app.configurator.RegisterMigration(banktypes.ModuleName, 1, bankMigrator.Migrate1to2); err != nil {
+		panic(err)
+	}
+
+	// create the simulation manager and define the order of the modules for deterministic simulations. The issuer
+	// module must precede the liquidityprovider and inflation modules, whose genesis states build on its issuers.
+	app.sm = module.NewSimulationManager(
+		auth.NewAppModule(appCodec, app.accountKeeper, authsims.RandomGenesisAccounts),
+		bank.NewAppModule(appCodec, app.bankKeeper, app.accountKeeper),
+		capability.NewAppModule(appCodec, *app.capabilityKeeper),
+		feegrantmodule.NewAppModule(appCodec, app.accountKeeper, app.bankKeeper, app.feeGrantKeeper, app.interfaceRegistry),
+		authzmodule.NewAppModule(appCodec, app.authzKeeper, app.accountKeeper, app.bankKeeper, interfaceRegistry),
+		staking.NewAppModule(appCodec, app.stakingKeeper, app.accountKeeper, app.bankKeeper, app.historykeeper),
+		emdistr.NewAppModule(distr.NewAppModule(appCodec, app.distrKeeper, app.accountKeeper, app.bankKeeper, app.stakingKeeper), app.distrKeeper, app.accountKeeper, app.bankKeeper, app.database),
+		params.NewAppModule(app.paramsKeeper),
+		evidence.NewAppModule(app.evidenceKeeper),
+		issuer.NewAppModule(appCodec, app.issuerKeeper, app.accountKeeper, app.bankKeeper, app.lpKeeper),
+		liquidityprovider.NewAppModule(appCodec, app.lpKeeper, app.accountKeeper, app.bankKeeper),
+		authority.NewAppModule(appCodec, app.authorityKeeper, app.accountKeeper, app.bankKeeper, app.issuerKeeper),
+		market.NewAppModule(appCodec, app.marketKeeper, app.accountKeeper, app.bankKeeper),
+		buyback.NewAppModule(appCodec, app.buybackKeeper, app.bankKeeper),
+		inflation.NewAppModule(appCodec, app.inflationKeeper),
+	)
 
-	app.mm.Modules[banktypes.ModuleName] = proxy
+	app.sm.RegisterStoreDecoders()
 
 	// initialize stores
 	app.MountKVStores(keys)
@@ -756,6 +784,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(market.ModuleName)
 
 	return paramsKeeper
 }
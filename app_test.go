@@ -10,9 +10,15 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	sdkauthtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
@@ -30,10 +36,14 @@ import (
 	apptypes "github.com/e-money/em-ledger/types"
 	"github.com/e-money/em-ledger/x/authority"
 	authtypes "github.com/e-money/em-ledger/x/authority/types"
+	"github.com/e-money/em-ledger/x/liquidityprovider"
+	marketkeeper "github.com/e-money/em-ledger/x/market/keeper"
+	markettypes "github.com/e-money/em-ledger/x/market/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
 	dbm "github.com/tendermint/tm-db"
@@ -861,3 +871,205 @@ func TestUpdatingBlockParams(t *testing.T) {
 	consensusParams = et.app.BaseApp.GetConsensusParams(et.ctx)
 	require.Equal(t, consensusParams.Block.String(), blockParams.String())
 }
+
+func TestBankMessagesNotifyBalanceListeners(t *testing.T) {
+	configOnce.Do(apptypes.ConfigureSDK)
+
+	et := emAppTests{}.initEmApp(t)
+	app, ctx := et.app, et.ctx
+
+	fund := accountFunder(t, app, ctx)
+	// The market only accepts orders for denominations with a supply.
+	fund(sdk.AccAddress(tmrand.Bytes(20)), "1000usd")
+
+	specs := map[string]struct {
+		msg          func(from, to sdk.AccAddress) sdk.Msg
+		expRemaining sdk.Int
+	}{
+		"send": {
+			msg: func(from, to sdk.AccAddress) sdk.Msg {
+				return banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("eur", 100)))
+			},
+			expRemaining: sdk.NewInt(900),
+		},
+		"multi send": {
+			msg: func(from, to sdk.AccAddress) sdk.Msg {
+				return banktypes.NewMsgMultiSend(
+					[]banktypes.Input{banktypes.NewInput(from, sdk.NewCoins(sdk.NewInt64Coin("eur", 300)))},
+					[]banktypes.Output{banktypes.NewOutput(to, sdk.NewCoins(sdk.NewInt64Coin("eur", 300)))},
+				)
+			},
+			expRemaining: sdk.NewInt(700),
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			owner, recipient := sdk.AccAddress(tmrand.Bytes(20)), sdk.AccAddress(tmrand.Bytes(20))
+			fund(owner, "1000eur")
+
+			o, err := markettypes.NewOrder(ctx.BlockTime(), markettypes.TimeInForce_GoodTillCancel,
+				sdk.NewInt64Coin("eur", 1000), sdk.NewInt64Coin("usd", 1200), owner, tmrand.Str(10))
+			require.NoError(t, err)
+			require.NoError(t, app.marketKeeper.NewOrderSingle(ctx, o))
+
+			msg := spec.msg(owner, recipient)
+			handler := app.MsgServiceRouter().Handler(msg)
+			require.NotNil(t, handler)
+			_, err = handler(ctx, msg)
+			require.NoError(t, err)
+
+			updated := app.marketKeeper.GetOrderByOwnerAndClientOrderId(ctx, owner.String(), o.ClientOrderID)
+			require.NotNil(t, updated)
+			require.Equal(t, spec.expRemaining, updated.SourceRemaining)
+		})
+	}
+}
+
+func TestBankSendChargesBalanceChangeGas(t *testing.T) {
+	configOnce.Do(apptypes.ConfigureSDK)
+
+	et := emAppTests{}.initEmApp(t)
+	app := et.app
+	txConfig := MakeEncodingConfig().TxConfig
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1, Time: time.Now()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.BaseApp.NewContext(false, header)
+
+	// Lift the block gas limit of the test genesis for the transactions of the next block.
+	blockParams := app.BaseApp.GetConsensusParams(ctx).Block
+	blockParams.MaxGas = -1
+	app.GetSubspace(baseapp.Paramspace).Set(ctx, baseapp.ParamStoreKeyBlockParams, blockParams)
+
+	fund := accountFunder(t, app, ctx)
+	fund(sdk.AccAddress(tmrand.Bytes(20)), "1000usd")
+
+	type sender struct {
+		key    *secp256k1.PrivKey
+		orders int
+	}
+
+	// Each sender with orders is compared to an identical sender without orders, so the difference in gas is the
+	// gas charged by the market for re-evaluating the orders.
+	// The senders are left with 50eur, which covers a single order.
+	specs := map[string]struct {
+		orders    int
+		expGas    uint64
+		expOrders int // after the transaction, before EndBlock
+	}{
+		"no orders":  {orders: 0, expGas: 0, expOrders: 0},
+		"one order":  {orders: 1, expGas: 2500 + 500, expOrders: 1},
+		"ten orders": {orders: 10, expGas: 2500 + 10*500, expOrders: 1},
+		"orders beyond the re-evaluation limit": {
+			orders:    60,
+			expGas:    2500 + 50*500,
+			expOrders: 60,
+		},
+	}
+
+	senders := make(map[string][2]sender)
+	for name, spec := range specs {
+		withOrders, withoutOrders := sender{secp256k1.GenPrivKey(), spec.orders}, sender{secp256k1.GenPrivKey(), 0}
+		for _, s := range []sender{withOrders, withoutOrders} {
+			owner := sdk.AccAddress(s.key.PubKey().Address())
+			fund(owner, "10000eur")
+
+			for i := 0; i < s.orders; i++ {
+				o, err := markettypes.NewOrder(ctx.BlockTime(), markettypes.TimeInForce_GoodTillCancel,
+					sdk.NewInt64Coin("eur", 100), sdk.NewInt64Coin("usd", 120), owner, tmrand.Str(10))
+				require.NoError(t, err)
+				require.NoError(t, app.marketKeeper.NewOrderSingle(ctx, o))
+			}
+		}
+		senders[name] = [2]sender{withOrders, withoutOrders}
+	}
+
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	header = tmproto.Header{Height: app.LastBlockHeight() + 1, Time: header.Time.Add(time.Second)}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	deliverSend := func(s sender) uint64 {
+		from := sdk.AccAddress(s.key.PubKey().Address())
+		acc := app.accountKeeper.GetAccount(app.BaseApp.NewContext(false, header), from)
+		require.NotNil(t, acc)
+
+		// The transaction is signed without a memo, as its size is charged.
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(from, sdk.AccAddress(tmrand.Bytes(20)), sdk.NewCoins(sdk.NewInt64Coin("eur", 9950)))))
+		builder.SetGasLimit(1000000)
+
+		signMode := txConfig.SignModeHandler().DefaultMode()
+		require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+			PubKey:   s.key.PubKey(),
+			Data:     &signing.SingleSignatureData{SignMode: signMode},
+			Sequence: acc.GetSequence(),
+		}))
+		signerData := authsigning.SignerData{AccountNumber: acc.GetAccountNumber(), Sequence: acc.GetSequence()}
+		sig, err := clienttx.SignWithPrivKey(signMode, signerData, builder, s.key, txConfig, acc.GetSequence())
+		require.NoError(t, err)
+		require.NoError(t, builder.SetSignatures(sig))
+
+		gasInfo, _, err := app.BaseApp.Deliver(txConfig.TxEncoder(), builder.GetTx())
+		require.NoError(t, err)
+		return gasInfo.GasUsed
+	}
+
+	for name, spec := range specs {
+		withOrders, withoutOrders := senders[name][0], senders[name][1]
+		require.Equal(t, spec.expGas, deliverSend(withOrders)-deliverSend(withoutOrders), name)
+
+		ctx = app.BaseApp.NewContext(false, header)
+		owner := sdk.AccAddress(withOrders.key.PubKey().Address())
+		require.Len(t, app.marketKeeper.GetOrdersByOwner(ctx, owner), spec.expOrders, name)
+	}
+
+	// The re-evaluation beyond the limit is left to EndBlock
+	app.EndBlock(abci.RequestEndBlock{})
+	app.Commit()
+
+	ctx = app.BaseApp.NewContext(true, header)
+	for name, spec := range specs {
+		owner := sdk.AccAddress(senders[name][0].key.PubKey().Address())
+		expOrders := 0
+		if spec.orders > 0 {
+			expOrders = 1
+		}
+		require.Len(t, app.marketKeeper.GetOrdersByOwner(ctx, owner), expOrders, name)
+	}
+
+	msg, broken := marketkeeper.AllInvariants(app.marketKeeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+// accountFunder returns a function that mints coins to an account. Tests running before the SDK is configured leave
+// module addresses cached with the default bech32 prefix, so the minting module account is stored with an explicitly
+// encoded address.
+func accountFunder(t *testing.T, app *EMoneyApp, ctx sdk.Context) func(acc sdk.AccAddress, amt string) {
+	lpAddr, err := bech32.ConvertAndEncode(apptypes.Bech32PrefixAccAddr, sdkauthtypes.NewModuleAddress(liquidityprovider.ModuleName))
+	require.NoError(t, err)
+	lpAcc := app.accountKeeper.GetModuleAccount(ctx, liquidityprovider.ModuleName).(*sdkauthtypes.ModuleAccount)
+	lpAcc.Address = lpAddr
+	app.accountKeeper.SetModuleAccount(ctx, lpAcc)
+
+	return func(acc sdk.AccAddress, amt string) {
+		coins, err := sdk.ParseCoinsNormalized(amt)
+		require.NoError(t, err)
+		require.NoError(t, app.bankKeeper.MintCoins(ctx, liquidityprovider.ModuleName, coins))
+		require.NoError(t, app.bankKeeper.SendCoinsFromModuleToAccount(ctx, liquidityprovider.ModuleName, acc, coins))
+	}
+}
+
+func TestBankMigrationRegistered(t *testing.T) {
+	configOnce.Do(apptypes.ConfigureSDK)
+
+	et := emAppTests{}.initEmApp(t)
+
+	fromVM := et.app.mm.GetVersionMap()
+	fromVM[banktypes.ModuleName] = 1
+
+	toVM, err := et.app.mm.RunMigrations(et.ctx, et.app.configurator, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, et.app.mm.Modules[banktypes.ModuleName].ConsensusVersion(), toVM[banktypes.ModuleName])
+}
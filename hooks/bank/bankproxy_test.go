@@ -90,9 +90,11 @@ func TestSendCoinsBalanceUpdateNotification(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			receivedDenoms := make([][][]string, spec.listenerCount)
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, []string) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, denoms []string) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
+					receivedDenoms[listenerNb] = append(receivedDenoms[listenerNb], denoms)
 				}
 			}
 
@@ -120,6 +122,7 @@ func TestSendCoinsBalanceUpdateNotification(t *testing.T) {
 			for i := 0; i < spec.listenerCount; i++ {
 				require.Len(t, receivedAddr[i], 1)
 				assert.Equal(t, spec.expAddr, receivedAddr[i][0])
+				assert.Equal(t, []string{"token"}, receivedDenoms[i][0])
 			}
 		})
 	}
@@ -138,20 +141,23 @@ func TestInputOutputCoinsBalanceUpdateNotification(t *testing.T) {
 		listenerCount      int
 		nestedKeeperResult error
 
-		expAddr []sdk.AccAddress
-		expErr  bool
+		expAddr   []sdk.AccAddress
+		expDenoms []string
+		expErr    bool
 	}{
 		"one listener called": {
-			srcInput:      []banktypes.Input{{Address: addr1.String()}},
-			srcOutput:     []banktypes.Output{{Address: addr2.String()}},
+			srcInput:      []banktypes.Input{{Address: addr1.String(), Coins: coins("1token")}},
+			srcOutput:     []banktypes.Output{{Address: addr2.String(), Coins: coins("1token")}},
 			listenerCount: 1,
 			expAddr:       []sdk.AccAddress{addr1, addr2},
+			expDenoms:     []string{"token"},
 		},
 		"multiple listener called": {
-			srcInput:      []banktypes.Input{{Address: addr1.String()}},
-			srcOutput:     []banktypes.Output{{Address: addr2.String()}},
+			srcInput:      []banktypes.Input{{Address: addr1.String(), Coins: coins("1token")}},
+			srcOutput:     []banktypes.Output{{Address: addr2.String(), Coins: coins("1token")}},
 			listenerCount: 2,
 			expAddr:       []sdk.AccAddress{addr1, addr2},
+			expDenoms:     []string{"token"},
 		},
 		"no listener called on error": {
 			srcInput:           []banktypes.Input{{Address: addr1.String()}},
@@ -161,18 +167,29 @@ func TestInputOutputCoinsBalanceUpdateNotification(t *testing.T) {
 			expErr:             true,
 		},
 		"deduplicated": {
-			srcInput:      []banktypes.Input{{Address: addr1.String()}, {Address: addr1.String()}, {Address: addr2.String()}},
-			srcOutput:     []banktypes.Output{{Address: addr2.String()}, {Address: addr2.String()}, {Address: addr1.String()}},
+			srcInput: []banktypes.Input{
+				{Address: addr1.String(), Coins: coins("1blx")},
+				{Address: addr1.String(), Coins: coins("1alx,1blx")},
+				{Address: addr2.String(), Coins: coins("1clx")},
+			},
+			srcOutput: []banktypes.Output{
+				{Address: addr2.String(), Coins: coins("1alx,1blx")},
+				{Address: addr2.String(), Coins: coins("1blx")},
+				{Address: addr1.String(), Coins: coins("1clx")},
+			},
 			listenerCount: 2,
 			expAddr:       []sdk.AccAddress{addr1, addr2},
+			expDenoms:     []string{"alx", "blx", "clx"},
 		},
 	}
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			receivedDenoms := make([][][]string, spec.listenerCount)
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, []string) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, denoms []string) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
+					receivedDenoms[listenerNb] = append(receivedDenoms[listenerNb], denoms)
 				}
 			}
 
@@ -200,6 +217,7 @@ func TestInputOutputCoinsBalanceUpdateNotification(t *testing.T) {
 			for i := 0; i < spec.listenerCount; i++ {
 				require.Len(t, receivedAddr[i], 1)
 				assert.Equal(t, spec.expAddr, receivedAddr[i][0])
+				assert.Equal(t, spec.expDenoms, receivedDenoms[i][0])
 			}
 		})
 	}
@@ -243,9 +261,11 @@ func TestSendCoinsFromModuleToAccount(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			receivedDenoms := make([][][]string, spec.listenerCount)
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, []string) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, denoms []string) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
+					receivedDenoms[listenerNb] = append(receivedDenoms[listenerNb], denoms)
 				}
 			}
 
@@ -274,6 +294,7 @@ func TestSendCoinsFromModuleToAccount(t *testing.T) {
 			for i := 0; i < spec.listenerCount; i++ {
 				require.Len(t, receivedAddr[i], 1)
 				assert.Equal(t, spec.expAddr, receivedAddr[i][0])
+				assert.Equal(t, []string{"alx", "blx"}, receivedDenoms[i][0])
 			}
 		})
 	}
@@ -317,9 +338,11 @@ func TestSendCoinsFromAccountToModule(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			receivedDenoms := make([][][]string, spec.listenerCount)
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, []string) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, denoms []string) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
+					receivedDenoms[listenerNb] = append(receivedDenoms[listenerNb], denoms)
 				}
 			}
 
@@ -349,6 +372,7 @@ func TestSendCoinsFromAccountToModule(t *testing.T) {
 			for i := 0; i < spec.listenerCount; i++ {
 				require.Len(t, receivedAddr[i], 1)
 				assert.Equal(t, spec.expAddr, receivedAddr[i][0])
+				assert.Equal(t, []string{"alx", "blx"}, receivedDenoms[i][0])
 			}
 		})
 	}
@@ -392,9 +416,11 @@ func TestDelegateCoinsFromAccountToModule(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			receivedDenoms := make([][][]string, spec.listenerCount)
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, []string) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, denoms []string) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
+					receivedDenoms[listenerNb] = append(receivedDenoms[listenerNb], denoms)
 				}
 			}
 
@@ -424,6 +450,7 @@ func TestDelegateCoinsFromAccountToModule(t *testing.T) {
 			for i := 0; i < spec.listenerCount; i++ {
 				require.Len(t, receivedAddr[i], 1)
 				assert.Equal(t, spec.expAddr, receivedAddr[i][0])
+				assert.Equal(t, []string{"alx", "blx"}, receivedDenoms[i][0])
 			}
 		})
 	}
@@ -467,9 +494,11 @@ func TestUndelegateCoinsFromModuleToAccount(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			receivedDenoms := make([][][]string, spec.listenerCount)
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, []string) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, denoms []string) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
+					receivedDenoms[listenerNb] = append(receivedDenoms[listenerNb], denoms)
 				}
 			}
 
@@ -498,6 +527,7 @@ func TestUndelegateCoinsFromModuleToAccount(t *testing.T) {
 			for i := 0; i < spec.listenerCount; i++ {
 				require.Len(t, receivedAddr[i], 1)
 				assert.Equal(t, spec.expAddr, receivedAddr[i][0])
+				assert.Equal(t, []string{"alx", "blx"}, receivedDenoms[i][0])
 			}
 		})
 	}
@@ -542,9 +572,11 @@ func TestDelegateCoins(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			receivedDenoms := make([][][]string, spec.listenerCount)
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, []string) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, denoms []string) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
+					receivedDenoms[listenerNb] = append(receivedDenoms[listenerNb], denoms)
 				}
 			}
 
@@ -574,6 +606,7 @@ func TestDelegateCoins(t *testing.T) {
 			for i := 0; i < spec.listenerCount; i++ {
 				require.Len(t, receivedAddr[i], 1)
 				assert.Equal(t, spec.expAddr, receivedAddr[i][0])
+				assert.Equal(t, []string{"alx", "blx"}, receivedDenoms[i][0])
 			}
 		})
 	}
@@ -618,9 +651,11 @@ func TestUndelegateCoins(t *testing.T) {
 	for name, spec := range specs {
 		t.Run(name, func(t *testing.T) {
 			receivedAddr := make([][][]sdk.AccAddress, spec.listenerCount)
-			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress) {
-				return func(_ sdk.Context, addrs []sdk.AccAddress) {
+			receivedDenoms := make([][][]string, spec.listenerCount)
+			newListener := func(listenerNb int) func(sdk.Context, []sdk.AccAddress, []string) {
+				return func(_ sdk.Context, addrs []sdk.AccAddress, denoms []string) {
 					receivedAddr[listenerNb] = append(receivedAddr[listenerNb], addrs)
+					receivedDenoms[listenerNb] = append(receivedDenoms[listenerNb], denoms)
 				}
 			}
 
@@ -650,6 +685,7 @@ func TestUndelegateCoins(t *testing.T) {
 			for i := 0; i < spec.listenerCount; i++ {
 				require.Len(t, receivedAddr[i], 1)
 				assert.Equal(t, spec.expAddr, receivedAddr[i][0])
+				assert.Equal(t, []string{"alx", "blx"}, receivedDenoms[i][0])
 			}
 		})
 	}
@@ -2,6 +2,7 @@ package bank
 
 import (
 	"context"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -13,21 +14,23 @@ var _ bankkeeper.Keeper = (*ProxyKeeper)(nil)
 
 type ProxyKeeper struct {
 	bk        bankkeeper.Keeper
-	listeners []func(sdk.Context, []sdk.AccAddress)
+	listeners []func(sdk.Context, []sdk.AccAddress, []string)
 }
 
 func Wrap(bk bankkeeper.Keeper) *ProxyKeeper {
 	return &ProxyKeeper{bk: bk}
 }
 
-func (pk *ProxyKeeper) AddBalanceListener(l func(sdk.Context, []sdk.AccAddress)) {
+// AddBalanceListener registers a listener that is called with the accounts whose balances of the given denominations
+// have changed.
+func (pk *ProxyKeeper) AddBalanceListener(l func(sdk.Context, []sdk.AccAddress, []string)) {
 	pk.listeners = append(pk.listeners, l)
 }
 
-func (pk ProxyKeeper) notifyListeners(ctx sdk.Context, accounts ...sdk.AccAddress) {
+func (pk ProxyKeeper) notifyListeners(ctx sdk.Context, denoms []string, accounts ...sdk.AccAddress) {
 	accounts = deduplicate(accounts)
 	for _, l := range pk.listeners {
-		l(ctx, accounts)
+		l(ctx, accounts, denoms)
 	}
 }
 
@@ -44,6 +47,23 @@ func deduplicate(accounts []sdk.AccAddress) []sdk.AccAddress {
 	return r
 }
 
+// denominations returns the sorted, distinct denominations of the coins.
+func denominations(coins ...sdk.Coins) []string {
+	idx := make(map[string]struct{})
+	r := make([]string, 0)
+	for _, c := range coins {
+		for _, coin := range c {
+			if _, exists := idx[coin.Denom]; exists {
+				continue
+			}
+			r = append(r, coin.Denom)
+			idx[coin.Denom] = struct{}{}
+		}
+	}
+	sort.Strings(r)
+	return r
+}
+
 func (pk ProxyKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
 	err := pk.bk.InputOutputCoins(ctx, inputs, outputs)
 	if err != nil {
@@ -51,17 +71,20 @@ func (pk ProxyKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input
 	}
 
 	accounts := make([]sdk.AccAddress, 0, len(inputs)+len(outputs))
+	amt := make([]sdk.Coins, 0, len(inputs))
 	for _, a := range inputs {
 		// invalid addresses were handled before in the wrapped keeper
 		addr, _ := sdk.AccAddressFromBech32(a.Address)
 		accounts = append(accounts, addr)
+		// the outputs add up to the inputs
+		amt = append(amt, a.Coins)
 	}
 	for _, a := range outputs {
 		addr, _ := sdk.AccAddressFromBech32(a.Address)
 		accounts = append(accounts, addr)
 	}
 
-	pk.notifyListeners(ctx, accounts...)
+	pk.notifyListeners(ctx, denominations(amt...), accounts...)
 	return nil
 }
 
@@ -70,7 +93,7 @@ func (pk ProxyKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, fromAddr, toAddr)
+	pk.notifyListeners(ctx, denominations(amt), fromAddr, toAddr)
 	return nil
 }
 
@@ -192,7 +215,7 @@ func (pk *ProxyKeeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModul
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, recipientAddr)
+	pk.notifyListeners(ctx, denominations(amt), recipientAddr)
 	return nil
 }
 
@@ -205,7 +228,7 @@ func (pk *ProxyKeeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, senderAddr)
+	pk.notifyListeners(ctx, denominations(amt), senderAddr)
 	return nil
 }
 
@@ -214,7 +237,7 @@ func (pk *ProxyKeeper) DelegateCoinsFromAccountToModule(ctx sdk.Context, senderA
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, senderAddr)
+	pk.notifyListeners(ctx, denominations(amt), senderAddr)
 	return nil
 }
 
@@ -223,7 +246,7 @@ func (pk *ProxyKeeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Context, sende
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, recipientAddr)
+	pk.notifyListeners(ctx, denominations(amt), recipientAddr)
 	return nil
 }
 
@@ -236,7 +259,7 @@ func (pk *ProxyKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAd
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, delegatorAddr)
+	pk.notifyListeners(ctx, denominations(amt), delegatorAddr)
 	return nil
 }
 
@@ -245,7 +268,7 @@ func (pk *ProxyKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegator
 	if err != nil {
 		return err
 	}
-	pk.notifyListeners(ctx, delegatorAddr)
+	pk.notifyListeners(ctx, denominations(amt), delegatorAddr)
 	return nil
 }
 
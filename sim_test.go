@@ -0,0 +1,107 @@
+package emoney
+
+import (
+	"encoding/json"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/stretchr/testify/require"
+)
+
+// Get flags every time the simulator is run
+func init() {
+	simapp.GetSimulatorFlags()
+}
+
+// TestFullAppSimulation fuzzes the state machine with random messages of all the modules that support simulation:
+//
+//	go test -run TestFullAppSimulation -Enabled=true -NumBlocks=100 -BlockSize=50 -Commit=true -v -timeout 1h
+func TestFullAppSimulation(t *testing.T) {
+	config, db, dir, logger, skip, err := simapp.SetupSimulation("leveldb-app-sim", "Simulation")
+	if skip {
+		t.Skip("skipping application simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		db.Close()
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	app := NewApp(
+		logger, db, nil, true, map[int64]bool{}, t.TempDir(), simapp.FlagPeriodValue, MakeEncodingConfig(),
+		EmptyAppOptions{},
+	)
+
+	// The BeginBlocker rejects block times too far ahead of the wall clock, so the simulated chain must start far enough
+	// in the past to fit all blocks.
+	if simapp.FlagGenesisTimeValue == 0 {
+		maxBlockDuration := 10000 * time.Second
+		simapp.FlagGenesisTimeValue = time.Now().Add(-time.Duration(config.NumBlocks) * maxBlockDuration).Unix()
+	}
+
+	// run randomized simulation
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		appStateFn(app.AppCodec(), app.SimulationManager()),
+		simtypes.RandomAccounts,
+		simapp.SimulationOperations(app, app.AppCodec(), config),
+		app.ModuleAccountAddrs(),
+		config,
+		app.AppCodec(),
+	)
+
+	// export state and simParams before the simulation error is checked
+	err = simapp.CheckExportSimulation(app, config, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	if config.Commit {
+		simapp.PrintStats(db)
+	}
+}
+
+// appStateFn wraps the SDK simulation genesis, which starts out from the default genesis of the SDK simulation app.
+// Modules without a genesis generator are reset to their e-money defaults and modules that are not part of this app
+// are dropped.
+func appStateFn(cdc codec.JSONCodec, sm *module.SimulationManager) simtypes.AppStateFn {
+	simulated := make(map[string]bool)
+	for _, m := range sm.Modules {
+		if named, ok := m.(interface{ Name() string }); ok {
+			simulated[named.Name()] = true
+		}
+	}
+
+	sdkAppStateFn := simapp.AppStateFn(cdc, sm)
+	return func(r *rand.Rand, accs []simtypes.Account, config simtypes.Config) (json.RawMessage, []simtypes.Account, string, time.Time) {
+		appState, simAccs, chainID, genesisTimestamp := sdkAppStateFn(r, accs, config)
+
+		rawState := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(appState, &rawState); err != nil {
+			panic(err)
+		}
+
+		genesisState := ModuleBasics.DefaultGenesis(cdc)
+		for name := range genesisState {
+			if simulated[name] {
+				genesisState[name] = rawState[name]
+			}
+		}
+
+		appState, err := json.Marshal(genesisState)
+		if err != nil {
+			panic(err)
+		}
+
+		return appState, simAccs, chainID, genesisTimestamp
+	}
+}
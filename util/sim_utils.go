@@ -0,0 +1,90 @@
+package util
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// SimulationBankKeeper is the bank functionality needed to deliver simulated messages.
+type SimulationBankKeeper interface {
+	simulation.BankKeeper
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// DeliverSimulatedMsg signs msg with the key of simAccount and delivers it with random fees. The fees are deducted and
+// the message is executed against a cached context first. A message rejected with insufficient funds or one of
+// expectedErrs is reported as a no-op, as random messages cannot always be funded or valid in the current state. Any
+// other rejection fails the simulation. Coins that the message spends or reserves are excluded from the fees.
+func DeliverSimulatedMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, ak simulation.AccountKeeper, bk SimulationBankKeeper,
+	simAccount simtypes.Account, msg legacytx.LegacyMsg, coinsSpentInMsg sdk.Coins, expectedErrs ...error,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	handler := app.MsgServiceRouter().Handler(msg)
+	if handler == nil {
+		return simtypes.NoOpMsg(msg.Route(), msg.Type(), "no handler for message"), nil, nil
+	}
+
+	spendable := bk.SpendableCoins(ctx, simAccount.Address)
+	coins, hasNeg := spendable.SafeSub(coinsSpentInMsg)
+	if hasNeg {
+		return simtypes.NoOpMsg(msg.Route(), msg.Type(), "message doesn't leave room for fees"), nil, nil
+	}
+
+	fees, err := simtypes.RandomFees(r, ctx, coins)
+	if err != nil {
+		return simtypes.NoOpMsg(msg.Route(), msg.Type(), "unable to generate fees"), nil, err
+	}
+
+	// Fees affect the balances that messages such as market orders are validated against.
+	cacheCtx, _ := ctx.CacheContext()
+	if !fees.IsZero() {
+		if err := bk.SendCoinsFromAccountToModule(cacheCtx, simAccount.Address, authtypes.FeeCollectorName, fees); err != nil {
+			return simtypes.NoOpMsg(msg.Route(), msg.Type(), err.Error()), nil, nil
+		}
+	}
+	if _, err := handler(cacheCtx, msg); err != nil {
+		if !isExpectedErr(err, expectedErrs) {
+			return simtypes.NoOpMsg(msg.Route(), msg.Type(), "unexpected rejection"), nil, err
+		}
+		return simtypes.NoOpMsg(msg.Route(), msg.Type(), err.Error()), nil, nil
+	}
+
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Msg:             msg,
+		MsgType:         msg.Type(),
+		CoinsSpentInMsg: coinsSpentInMsg,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      msg.Route(),
+	}
+
+	return simulation.GenAndDeliverTx(txCtx, fees)
+}
+
+func isExpectedErr(err error, expectedErrs []error) bool {
+	if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
+		return true
+	}
+
+	for _, expected := range expectedErrs {
+		if errors.Is(err, expected) {
+			return true
+		}
+	}
+
+	return false
+}
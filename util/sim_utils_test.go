@@ -0,0 +1,27 @@
+package util
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsExpectedErr(t *testing.T) {
+	errExpected := sdkerrors.Register("simtest", 1, "expected rejection")
+
+	testdata := []struct {
+		err      error
+		expected bool
+	}{
+		{sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "10eeur"), true},
+		{errExpected, true},
+		{sdkerrors.Wrapf(errExpected, "batch item %v", 1), true},
+		{sdkerrors.ErrInvalidAddress, false},
+		{sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "signer"), false},
+	}
+
+	for _, d := range testdata {
+		assert.Equal(t, d.expected, isExpectedErr(d.err, []error{errExpected}), d.err.Error())
+	}
+}
@@ -34,6 +34,10 @@ func GetTxCmd() *cobra.Command {
 		GetCmdReplaceAuthority(),
 		GetCmdScheduleUpgrade(),
 		getCmdSetParameters(),
+		getCmdHaltTrading(),
+		getCmdResumeTrading(),
+		getCmdSetInstrumentConfig(),
+		getCmdRemoveInstrumentConfig(),
 	)
 
 	return authorityCmds
@@ -344,6 +348,144 @@ Where proposal.json contains:
 	return cmd
 }
 
+func getCmdHaltTrading() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "halt-trading [authority_key_or_address] [source_denom] [destination_denom]",
+		Example: "emd tx authority halt-trading masterkey eeur echf",
+		Short:   "Halt trading in both directions of a market instrument",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgHaltTrading{
+				Authority:   clientCtx.GetFromAddress().String(),
+				Source:      args[1],
+				Destination: args[2],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdResumeTrading() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "resume-trading [authority_key_or_address] [source_denom] [destination_denom]",
+		Example: "emd tx authority resume-trading masterkey eeur echf",
+		Short:   "Resume trading in a halted market instrument",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgResumeTrading{
+				Authority:   clientCtx.GetFromAddress().String(),
+				Source:      args[1],
+				Destination: args[2],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdSetInstrumentConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-instrument-config [authority_key_or_address] [source_denom] [destination_denom] [tick_size] [lot_size] [min_notional]",
+		Example: "emd tx authority set-instrument-config masterkey eeur echf 0.0001 1000 100000",
+		Short:   "Set the tick size, lot size and minimum notional of the orders that sell source for destination",
+		Long: strings.TrimSpace(`
+The tick size is stated in destination per source, the lot size in source and the minimum notional in destination.
+A value of zero leaves the respective property of the orders unrestricted.
+`),
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			tickSize, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tick size: %v", err)
+			}
+
+			lotSize, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lot size: %v", args[4])
+			}
+
+			minNotional, ok := sdk.NewIntFromString(args[5])
+			if !ok {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "minimum notional: %v", args[5])
+			}
+
+			msg := &types.MsgSetInstrumentConfig{
+				Authority:   clientCtx.GetFromAddress().String(),
+				Source:      args[1],
+				Destination: args[2],
+				TickSize:    tickSize,
+				LotSize:     lotSize,
+				MinNotional: minNotional,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func getCmdRemoveInstrumentConfig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-instrument-config [authority_key_or_address] [source_denom] [destination_denom]",
+		Example: "emd tx authority remove-instrument-config masterkey eeur echf",
+		Short:   "Revert a market instrument to the module-wide tick size, lot size and minimum notional",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.Flags().Set(flags.FlagFrom, args[0])
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveInstrumentConfig{
+				Authority:   clientCtx.GetFromAddress().String(),
+				Source:      args[1],
+				Destination: args[2],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // parseParamChangesJSON reads and parses a ParamChangesJSON from file.
 func parseParamChangesJSON(cdc *codec.LegacyAmino, jsonFile string) (utils.ParamChangesJSON, error) {
 	params := utils.ParamChangesJSON{}
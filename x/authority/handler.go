@@ -40,6 +40,22 @@ func newHandler(k Keeper) sdk.Handler {
 			res, err := msgServer.SetParameters(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgHaltTrading:
+			res, err := msgServer.HaltTrading(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgResumeTrading:
+			res, err := msgServer.ResumeTrading(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetInstrumentConfig:
+			res, err := msgServer.SetInstrumentConfig(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgRemoveInstrumentConfig:
+			res, err := msgServer.RemoveInstrumentConfig(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
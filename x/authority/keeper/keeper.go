@@ -21,11 +21,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-const (
-	keyAuthorityAccAddress = "AuthorityAccountAddress"
-	keyGasPrices           = "GasPrices"
-)
-
 var _ authorityKeeper = Keeper{}
 
 type Keeper struct {
@@ -36,6 +31,7 @@ type Keeper struct {
 	upgradeKeeper types.UpgradeKeeper
 	paramsKeeper  types.ParamsKeeper
 	gpk           types.GasPricesKeeper
+	marketKeeper  types.MarketKeeper
 
 	gasPricesInit *sync.Once
 }
@@ -44,7 +40,7 @@ func NewKeeper(
 	cdc codec.Codec, storeKey sdk.StoreKey,
 	issuerKeeper issuer.Keeper, bankKeeper types.BankKeeper,
 	gasPricesKeeper types.GasPricesKeeper, upgradeKeeper types.UpgradeKeeper,
-	paramsKeeper types.ParamsKeeper,
+	paramsKeeper types.ParamsKeeper, marketKeeper types.MarketKeeper,
 ) Keeper {
 	return Keeper{
 		cdc:           cdc,
@@ -54,6 +50,7 @@ func NewKeeper(
 		storeKey:      storeKey,
 		upgradeKeeper: upgradeKeeper,
 		paramsKeeper:  paramsKeeper,
+		marketKeeper:  marketKeeper,
 
 		gasPricesInit: new(sync.Once),
 	}
@@ -84,7 +81,7 @@ func (k Keeper) saveAuthorities(
 			LastModified:  ctx.BlockTime(),
 		},
 	)
-	store.Set([]byte(keyAuthorityAccAddress), bz)
+	store.Set([]byte(types.KeyAuthorityAccAddress), bz)
 }
 
 func (k Keeper) getAuthorities(ctx sdk.Context) (authority, formerAuthority sdk.AccAddress, err error) {
@@ -108,7 +105,7 @@ func (k Keeper) getAuthorities(ctx sdk.Context) (authority, formerAuthority sdk.
 
 func (k Keeper) GetAuthoritySet(ctx sdk.Context) types.Authority {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte(keyAuthorityAccAddress))
+	bz := store.Get([]byte(types.KeyAuthorityAccAddress))
 	var authoritySet types.Authority
 	k.cdc.MustUnmarshal(bz, &authoritySet)
 	return authoritySet
@@ -154,7 +151,7 @@ func (k Keeper) SetGasPrices(ctx sdk.Context, authority sdk.AccAddress, newPrice
 	gasPrices := types.GasPrices{Minimum: newPrices}
 	bz := k.cdc.MustMarshalLengthPrefixed(&gasPrices)
 	store := ctx.KVStore(k.storeKey)
-	store.Set([]byte(keyGasPrices), bz)
+	store.Set([]byte(types.KeyGasPrices), bz)
 
 	if err := k.gpk.SetMinimumGasPrices(newPrices.String()); err != nil {
 		return nil, err
@@ -165,7 +162,7 @@ func (k Keeper) SetGasPrices(ctx sdk.Context, authority sdk.AccAddress, newPrice
 
 func (k Keeper) GetGasPrices(ctx sdk.Context) sdk.DecCoins {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte(keyGasPrices))
+	bz := store.Get([]byte(types.KeyGasPrices))
 
 	if bz == nil {
 		return nil
@@ -268,6 +265,68 @@ func (k Keeper) SetParams(ctx sdk.Context, authority sdk.AccAddress, changes []p
 	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
 }
 
+// HaltTrading suspends trading in both directions of the src/dst market instrument.
+func (k Keeper) HaltTrading(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error) {
+	if err := k.ValidateAuthority(ctx, authority); err != nil {
+		return nil, err
+	}
+
+	// The events of the market module are returned in the result rather than emitted to the caller.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	if err := k.marketKeeper.HaltTrading(ctx, src, dst); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// ResumeTrading resumes trading in both directions of the src/dst market instrument.
+func (k Keeper) ResumeTrading(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error) {
+	if err := k.ValidateAuthority(ctx, authority); err != nil {
+		return nil, err
+	}
+
+	// The events of the market module are returned in the result rather than emitted to the caller.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	if err := k.marketKeeper.ResumeTrading(ctx, src, dst); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// SetInstrumentConfig sets the tick size, lot size and minimum notional of the orders that sell src for dst.
+func (k Keeper) SetInstrumentConfig(
+	ctx sdk.Context, authority sdk.AccAddress, src, dst string, tickSize sdk.Dec, lotSize, minNotional sdk.Int,
+) (*sdk.Result, error) {
+	if err := k.ValidateAuthority(ctx, authority); err != nil {
+		return nil, err
+	}
+
+	// The events of the market module are returned in the result rather than emitted to the caller.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	if err := k.marketKeeper.SetInstrumentConfig(ctx, src, dst, tickSize, lotSize, minNotional); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
+// RemoveInstrumentConfig reverts the src/dst market instrument to the module-wide defaults.
+func (k Keeper) RemoveInstrumentConfig(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error) {
+	if err := k.ValidateAuthority(ctx, authority); err != nil {
+		return nil, err
+	}
+
+	// The events of the market module are returned in the result rather than emitted to the caller.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	if err := k.marketKeeper.RemoveInstrumentConfig(ctx, src, dst); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: ctx.EventManager().ABCIEvents()}, nil
+}
+
 // logger returns a module-specific logger.
 func logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
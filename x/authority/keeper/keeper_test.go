@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"math"
 	"testing"
 
@@ -284,6 +285,71 @@ func TestManageGasPrices1(t *testing.T) {
 	require.True(t, types.ErrUnknownDenom.Is(err))
 }
 
+func TestSetAndRemoveInstrumentConfig(t *testing.T) {
+	ctx, keeper, _, _ := createTestComponents(t)
+
+	var (
+		accAuthority = mustParseAddress("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		accRandom    = mustParseAddress("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+		mk           = keeper.marketKeeper.(*mockMarketKeeper)
+		tickSize     = sdk.NewDecWithPrec(1, 2)
+	)
+
+	keeper.BootstrapAuthority(ctx, accAuthority)
+
+	_, err := keeper.SetInstrumentConfig(ctx, accRandom, "eeur", "echf", tickSize, sdk.NewInt(100), sdk.NewInt(1000))
+	require.True(t, types.ErrNotAuthority.Is(err))
+	require.Empty(t, mk.configs)
+
+	_, err = keeper.SetInstrumentConfig(ctx, accAuthority, "eeur", "echf", tickSize, sdk.NewInt(100), sdk.NewInt(1000))
+	require.NoError(t, err)
+	require.Equal(t, tickSize, mk.configs["eeur/echf"])
+
+	_, err = keeper.RemoveInstrumentConfig(ctx, accRandom, "eeur", "echf")
+	require.True(t, types.ErrNotAuthority.Is(err))
+	require.Contains(t, mk.configs, "eeur/echf")
+
+	_, err = keeper.RemoveInstrumentConfig(ctx, accAuthority, "eeur", "echf")
+	require.NoError(t, err)
+	require.Empty(t, mk.configs)
+
+	// Errors of the market module are passed on.
+	_, err = keeper.RemoveInstrumentConfig(ctx, accAuthority, "eeur", "echf")
+	require.Error(t, err)
+}
+
+func TestHaltAndResumeTrading(t *testing.T) {
+	ctx, keeper, _, _ := createTestComponents(t)
+
+	var (
+		accAuthority = mustParseAddress("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		accRandom    = mustParseAddress("emoney17up20gamd0vh6g9ne0uh67hx8xhyfrv2lyazgu")
+		mk           = keeper.marketKeeper.(*mockMarketKeeper)
+	)
+
+	keeper.BootstrapAuthority(ctx, accAuthority)
+
+	_, err := keeper.HaltTrading(ctx, accRandom, "eeur", "echf")
+	require.True(t, types.ErrNotAuthority.Is(err))
+	require.Empty(t, mk.halted)
+
+	_, err = keeper.HaltTrading(ctx, accAuthority, "eeur", "echf")
+	require.NoError(t, err)
+	require.True(t, mk.halted["eeur/echf"])
+
+	_, err = keeper.ResumeTrading(ctx, accRandom, "eeur", "echf")
+	require.True(t, types.ErrNotAuthority.Is(err))
+	require.True(t, mk.halted["eeur/echf"])
+
+	_, err = keeper.ResumeTrading(ctx, accAuthority, "eeur", "echf")
+	require.NoError(t, err)
+	require.False(t, mk.halted["eeur/echf"])
+
+	// Errors of the market module are passed on.
+	_, err = keeper.ResumeTrading(ctx, accAuthority, "eeur", "echf")
+	require.Error(t, err)
+}
+
 func TestReplaceAuthority(t *testing.T) {
 	ctx, keeper, _, _ := createTestComponents(t)
 
@@ -361,7 +427,7 @@ func TestManageGasPrices2(t *testing.T) {
 	setGasPrices := func(gp sdk.DecCoins) {
 		bz := encConfig.Marshaler.MustMarshalLengthPrefixed(&types.GasPrices{Minimum: gp})
 		store := ctx.KVStore(keeper.storeKey)
-		store.Set([]byte(keyGasPrices), bz)
+		store.Set([]byte(types.KeyGasPrices), bz)
 	}
 
 	gp, _ := sdk.ParseDecCoins("0.00005eeur")
@@ -438,7 +504,7 @@ func createTestComponentWithEncodingConfig(t *testing.T, encConfig simappparams.
 		sdk.NewCoin("eeur", sdk.NewInt(5000))))
 
 	gpk := new(mockGasPricesKeeper)
-	keeper := NewKeeper(encConfig.Marshaler, authKey, ik, bk, gpk, upgK, pk)
+	keeper := NewKeeper(encConfig.Marshaler, authKey, ik, bk, gpk, upgK, pk, new(mockMarketKeeper))
 
 	return ctx, keeper, ik, gpk
 }
@@ -457,6 +523,47 @@ func (m *mockGasPricesKeeper) SetMinimumGasPrices(gasPricesStr string) error {
 	return nil
 }
 
+type mockMarketKeeper struct {
+	halted  map[string]bool
+	configs map[string]sdk.Dec
+}
+
+func (m *mockMarketKeeper) HaltTrading(_ sdk.Context, src, dst string) error {
+	if m.halted == nil {
+		m.halted = make(map[string]bool)
+	}
+
+	m.halted[src+"/"+dst] = true
+	return nil
+}
+
+func (m *mockMarketKeeper) ResumeTrading(_ sdk.Context, src, dst string) error {
+	if !m.halted[src+"/"+dst] {
+		return errors.New("not halted")
+	}
+
+	delete(m.halted, src+"/"+dst)
+	return nil
+}
+
+func (m *mockMarketKeeper) SetInstrumentConfig(_ sdk.Context, src, dst string, tickSize sdk.Dec, _, _ sdk.Int) error {
+	if m.configs == nil {
+		m.configs = make(map[string]sdk.Dec)
+	}
+
+	m.configs[src+"/"+dst] = tickSize
+	return nil
+}
+
+func (m *mockMarketKeeper) RemoveInstrumentConfig(_ sdk.Context, src, dst string) error {
+	if _, found := m.configs[src+"/"+dst]; !found {
+		return errors.New("not configured")
+	}
+
+	delete(m.configs, src+"/"+dst)
+	return nil
+}
+
 type mockInflationKeeper struct{}
 
 func (m mockInflationKeeper) SetInflation(ctx sdk.Context, inflation sdk.Dec, denom string) (_ *sdk.Result, _ error) {
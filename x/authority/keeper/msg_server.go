@@ -23,6 +23,10 @@ type authorityKeeper interface {
 	ScheduleUpgrade(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
 	GetUpgradePlan(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool)
 	SetParams(ctx sdk.Context, authority sdk.AccAddress, changes []proposal.ParamChange) (*sdk.Result, error)
+	HaltTrading(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error)
+	ResumeTrading(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error)
+	SetInstrumentConfig(ctx sdk.Context, authority sdk.AccAddress, src, dst string, tickSize sdk.Dec, lotSize, minNotional sdk.Int) (*sdk.Result, error)
+	RemoveInstrumentConfig(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error)
 }
 type msgServer struct {
 	k authorityKeeper
@@ -168,3 +172,83 @@ func (m msgServer) SetParameters(goCtx context.Context, msg *types.MsgSetParamet
 
 	return &types.MsgSetParametersResponse{}, nil
 }
+
+func (m msgServer) HaltTrading(goCtx context.Context, msg *types.MsgHaltTrading) (*types.MsgHaltTradingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	result, err := m.k.HaltTrading(ctx, authority, msg.Source, msg.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+
+	return &types.MsgHaltTradingResponse{}, nil
+}
+
+func (m msgServer) ResumeTrading(goCtx context.Context, msg *types.MsgResumeTrading) (*types.MsgResumeTradingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	result, err := m.k.ResumeTrading(ctx, authority, msg.Source, msg.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+
+	return &types.MsgResumeTradingResponse{}, nil
+}
+
+func (m msgServer) SetInstrumentConfig(goCtx context.Context, msg *types.MsgSetInstrumentConfig) (*types.MsgSetInstrumentConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	result, err := m.k.SetInstrumentConfig(ctx, authority, msg.Source, msg.Destination, msg.TickSize, msg.LotSize, msg.MinNotional)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+
+	return &types.MsgSetInstrumentConfigResponse{}, nil
+}
+
+func (m msgServer) RemoveInstrumentConfig(goCtx context.Context, msg *types.MsgRemoveInstrumentConfig) (*types.MsgRemoveInstrumentConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "authority")
+	}
+
+	result, err := m.k.RemoveInstrumentConfig(ctx, authority, msg.Source, msg.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, e := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(e))
+	}
+
+	return &types.MsgRemoveInstrumentConfigResponse{}, nil
+}
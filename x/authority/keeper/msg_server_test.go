@@ -606,16 +606,193 @@ func TestGrpcSetParams(t *testing.T) {
 	}
 }
 
+func TestGrpcHaltAndResumeTrading(t *testing.T) {
+	var (
+		authorityAddr = mustParseAddress("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		gotAuthority  sdk.AccAddress
+		gotInstrument []string
+	)
+
+	keeper := authorityKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	mockFn := func(err error) func(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error) {
+		return func(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error) {
+			if err != nil {
+				return nil, err
+			}
+
+			gotAuthority, gotInstrument = authority, []string{src, dst}
+			return &sdk.Result{
+				Events: []abcitypes.Event{{
+					Type:       "testing",
+					Attributes: []abcitypes.EventAttribute{{Key: []byte("foo"), Value: []byte("bar")}},
+				}},
+			}, nil
+		}
+	}
+
+	specs := map[string]struct {
+		halt      bool
+		authority string
+		mockErr   error
+		expErr    bool
+	}{
+		"halt": {
+			halt:      true,
+			authority: authorityAddr.String(),
+		},
+		"resume": {
+			authority: authorityAddr.String(),
+		},
+		"halt authority invalid": {
+			halt:      true,
+			authority: "invalid",
+			expErr:    true,
+		},
+		"resume authority invalid": {
+			authority: "invalid",
+			expErr:    true,
+		},
+		"halt processing failure": {
+			halt:      true,
+			authority: authorityAddr.String(),
+			mockErr:   errors.New("testing"),
+			expErr:    true,
+		},
+		"resume processing failure": {
+			authority: authorityAddr.String(),
+			mockErr:   errors.New("testing"),
+			expErr:    true,
+		},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotAuthority, gotInstrument = nil, nil
+			keeper.haltTradingfn = mockFn(spec.mockErr)
+			keeper.resumeTradingfn = mockFn(spec.mockErr)
+
+			eventManager := sdk.NewEventManager()
+			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(eventManager)
+
+			var gotErr error
+			if spec.halt {
+				_, gotErr = svr.HaltTrading(sdk.WrapSDKContext(ctx), &types.MsgHaltTrading{
+					Authority: spec.authority, Source: "eeur", Destination: "echf",
+				})
+			} else {
+				_, gotErr = svr.ResumeTrading(sdk.WrapSDKContext(ctx), &types.MsgResumeTrading{
+					Authority: spec.authority, Source: "eeur", Destination: "echf",
+				})
+			}
+
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Len(t, eventManager.Events(), 1)
+			assert.Equal(t, authorityAddr, gotAuthority)
+			assert.Equal(t, []string{"eeur", "echf"}, gotInstrument)
+		})
+	}
+}
+
+func TestGrpcSetAndRemoveInstrumentConfig(t *testing.T) {
+	var (
+		authorityAddr = mustParseAddress("emoney1kt0vh0ttget0xx77g6d3ttnvq2lnxx6vp3uyl0")
+		gotAuthority  sdk.AccAddress
+		gotInstrument []string
+		gotSizes      []string
+	)
+
+	keeper := authorityKeeperMock{
+		setInstrumentConfigfn: func(ctx sdk.Context, authority sdk.AccAddress, src, dst string, tickSize sdk.Dec, lotSize, minNotional sdk.Int) (*sdk.Result, error) {
+			gotAuthority, gotInstrument = authority, []string{src, dst}
+			gotSizes = []string{tickSize.String(), lotSize.String(), minNotional.String()}
+			return &sdk.Result{}, nil
+		},
+		removeInstrumentConfigfn: func(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error) {
+			gotAuthority, gotInstrument = authority, []string{src, dst}
+			return nil, errors.New("testing")
+		},
+	}
+	svr := NewMsgServerImpl(&keeper)
+	ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(sdk.NewEventManager())
+
+	_, err := svr.SetInstrumentConfig(sdk.WrapSDKContext(ctx), &types.MsgSetInstrumentConfig{
+		Authority: "invalid", Source: "eeur", Destination: "echf",
+		TickSize: sdk.NewDecWithPrec(1, 2), LotSize: sdk.NewInt(100), MinNotional: sdk.NewInt(1000),
+	})
+	require.Error(t, err)
+	require.Nil(t, gotAuthority)
+
+	_, err = svr.SetInstrumentConfig(sdk.WrapSDKContext(ctx), &types.MsgSetInstrumentConfig{
+		Authority: authorityAddr.String(), Source: "eeur", Destination: "echf",
+		TickSize: sdk.NewDecWithPrec(1, 2), LotSize: sdk.NewInt(100), MinNotional: sdk.NewInt(1000),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, authorityAddr, gotAuthority)
+	assert.Equal(t, []string{"eeur", "echf"}, gotInstrument)
+	assert.Equal(t, []string{"0.010000000000000000", "100", "1000"}, gotSizes)
+
+	// Processing failures are passed on.
+	_, err = svr.RemoveInstrumentConfig(sdk.WrapSDKContext(ctx), &types.MsgRemoveInstrumentConfig{
+		Authority: authorityAddr.String(), Source: "eeur", Destination: "echf",
+	})
+	require.Error(t, err)
+	assert.Equal(t, []string{"eeur", "echf"}, gotInstrument)
+}
+
 // mock implementation of authorityKeeper interface
 type authorityKeeperMock struct {
-	createIssuerfn     func(ctx sdk.Context, authority sdk.AccAddress, issuerAddress sdk.AccAddress, denoms []types.Denomination) (*sdk.Result, error)
-	destroyIssuerfn    func(ctx sdk.Context, authority sdk.AccAddress, issuerAddress sdk.AccAddress) (*sdk.Result, error)
-	SetGasPricesfn     func(ctx sdk.Context, authority sdk.AccAddress, gasprices sdk.DecCoins) (*sdk.Result, error)
-	replaceAuthorityfn func(ctx sdk.Context, authority, newAuthority sdk.AccAddress) (*sdk.Result, error)
-	scheduleUpgradefn  func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
-	getUpgradePlanfn   func(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool)
-	applyUpgradefn     func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
-	setParamsfn        func(ctx sdk.Context, authority sdk.AccAddress, changes []proposal.ParamChange) (*sdk.Result, error)
+	createIssuerfn           func(ctx sdk.Context, authority sdk.AccAddress, issuerAddress sdk.AccAddress, denoms []types.Denomination) (*sdk.Result, error)
+	destroyIssuerfn          func(ctx sdk.Context, authority sdk.AccAddress, issuerAddress sdk.AccAddress) (*sdk.Result, error)
+	SetGasPricesfn           func(ctx sdk.Context, authority sdk.AccAddress, gasprices sdk.DecCoins) (*sdk.Result, error)
+	replaceAuthorityfn       func(ctx sdk.Context, authority, newAuthority sdk.AccAddress) (*sdk.Result, error)
+	scheduleUpgradefn        func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
+	getUpgradePlanfn         func(ctx sdk.Context) (plan upgradetypes.Plan, havePlan bool)
+	applyUpgradefn           func(ctx sdk.Context, authority sdk.AccAddress, plan upgradetypes.Plan) (*sdk.Result, error)
+	setParamsfn              func(ctx sdk.Context, authority sdk.AccAddress, changes []proposal.ParamChange) (*sdk.Result, error)
+	haltTradingfn            func(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error)
+	resumeTradingfn          func(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error)
+	setInstrumentConfigfn    func(ctx sdk.Context, authority sdk.AccAddress, src, dst string, tickSize sdk.Dec, lotSize, minNotional sdk.Int) (*sdk.Result, error)
+	removeInstrumentConfigfn func(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error)
+}
+
+func (a authorityKeeperMock) SetInstrumentConfig(
+	ctx sdk.Context, authority sdk.AccAddress, src, dst string, tickSize sdk.Dec, lotSize, minNotional sdk.Int,
+) (*sdk.Result, error) {
+	if a.setInstrumentConfigfn == nil {
+		panic("not expected to be called")
+	}
+
+	return a.setInstrumentConfigfn(ctx, authority, src, dst, tickSize, lotSize, minNotional)
+}
+
+func (a authorityKeeperMock) RemoveInstrumentConfig(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error) {
+	if a.removeInstrumentConfigfn == nil {
+		panic("not expected to be called")
+	}
+
+	return a.removeInstrumentConfigfn(ctx, authority, src, dst)
+}
+
+func (a authorityKeeperMock) HaltTrading(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error) {
+	if a.haltTradingfn == nil {
+		panic("not expected to be called")
+	}
+
+	return a.haltTradingfn(ctx, authority, src, dst)
+}
+
+func (a authorityKeeperMock) ResumeTrading(ctx sdk.Context, authority sdk.AccAddress, src, dst string) (*sdk.Result, error) {
+	if a.resumeTradingfn == nil {
+		panic("not expected to be called")
+	}
+
+	return a.resumeTradingfn(ctx, authority, src, dst)
 }
 
 func (a authorityKeeperMock) SetParams(
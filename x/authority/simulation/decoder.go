@@ -0,0 +1,33 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/e-money/em-ledger/x/authority/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's Value to the corresponding authority
+// type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, []byte(types.KeyAuthorityAccAddress)):
+			var authorityA, authorityB types.Authority
+			cdc.MustUnmarshal(kvA.Value, &authorityA)
+			cdc.MustUnmarshal(kvB.Value, &authorityB)
+			return fmt.Sprintf("%v\n%v", authorityA, authorityB)
+
+		case bytes.Equal(kvA.Key, []byte(types.KeyGasPrices)):
+			var gasPricesA, gasPricesB types.GasPrices
+			cdc.MustUnmarshalLengthPrefixed(kvA.Value, &gasPricesA)
+			cdc.MustUnmarshalLengthPrefixed(kvB.Value, &gasPricesB)
+			return fmt.Sprintf("%v\n%v", gasPricesA, gasPricesB)
+
+		default:
+			panic(fmt.Sprintf("invalid authority key %X", kvA.Key))
+		}
+	}
+}
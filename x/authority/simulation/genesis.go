@@ -0,0 +1,24 @@
+package simulation
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/e-money/em-ledger/x/authority/types"
+)
+
+// RandomizedGenState generates a random GenesisState for the authority module. A random account is made the
+// authority and no minimum gas prices are enforced, so that the simulated transactions are not rejected for their fees.
+func RandomizedGenState(simState *module.SimulationState) {
+	authority, _ := simtypes.RandomAcc(simState.Rand, simState.Accounts)
+
+	authorityGenesis := types.GenesisState{
+		AuthorityKey: authority.Address.String(),
+		MinGasPrices: sdk.DecCoins{},
+	}
+
+	fmt.Printf("Selected randomly generated authority: %s\n", authorityGenesis.AuthorityKey)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&authorityGenesis)
+}
@@ -0,0 +1,402 @@
+package simulation
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/e-money/em-ledger/util"
+	"github.com/e-money/em-ledger/x/authority/keeper"
+	"github.com/e-money/em-ledger/x/authority/types"
+	markettypes "github.com/e-money/em-ledger/x/market/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateIssuer        = "op_weight_msg_create_issuer"
+	OpWeightMsgDestroyIssuer       = "op_weight_msg_destroy_issuer"
+	OpWeightMsgSetGasPrices        = "op_weight_msg_set_gas_prices"
+	OpWeightMsgReplaceAuthority    = "op_weight_msg_replace_authority"
+	OpWeightMsgScheduleUpgrade     = "op_weight_msg_schedule_upgrade"
+	OpWeightMsgSetParameters       = "op_weight_msg_set_parameters"
+	OpWeightMsgHaltTrading         = "op_weight_msg_halt_trading"
+	OpWeightMsgSetInstrumentConfig = "op_weight_msg_set_instrument_config"
+
+	DefaultWeightMsgCreateIssuer        = 5
+	DefaultWeightMsgDestroyIssuer       = 1
+	DefaultWeightMsgSetGasPrices        = 2
+	DefaultWeightMsgReplaceAuthority    = 1
+	DefaultWeightMsgScheduleUpgrade     = 1
+	DefaultWeightMsgSetParameters       = 2
+	DefaultWeightMsgHaltTrading         = 1
+	DefaultWeightMsgSetInstrumentConfig = 1
+)
+
+var (
+	TypeMsgCreateIssuer           = types.MsgCreateIssuer{}.Type()
+	TypeMsgDestroyIssuer          = types.MsgDestroyIssuer{}.Type()
+	TypeMsgSetGasPrices           = types.MsgSetGasPrices{}.Type()
+	TypeMsgReplaceAuthority       = types.MsgReplaceAuthority{}.Type()
+	TypeMsgScheduleUpgrade        = types.MsgScheduleUpgrade{}.Type()
+	TypeMsgSetParameters          = types.MsgSetParameters{}.Type()
+	TypeMsgHaltTrading            = types.MsgHaltTrading{}.Type()
+	TypeMsgResumeTrading          = types.MsgResumeTrading{}.Type()
+	TypeMsgSetInstrumentConfig    = types.MsgSetInstrumentConfig{}.Type()
+	TypeMsgRemoveInstrumentConfig = types.MsgRemoveInstrumentConfig{}.Type()
+)
+
+// WeightedOperations returns all the authority module operations with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper,
+	k keeper.Keeper, ik types.IssuerKeeper,
+) simulation.WeightedOperations {
+	weight := func(key string, defaultWeight int) int {
+		var w int
+		appParams.GetOrGenerate(cdc, key, &w, nil, func(_ *rand.Rand) { w = defaultWeight })
+		return w
+	}
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgCreateIssuer, DefaultWeightMsgCreateIssuer),
+			SimulateMsgCreateIssuer(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgDestroyIssuer, DefaultWeightMsgDestroyIssuer),
+			SimulateMsgDestroyIssuer(ak, bk, k, ik),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSetGasPrices, DefaultWeightMsgSetGasPrices),
+			SimulateMsgSetGasPrices(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgReplaceAuthority, DefaultWeightMsgReplaceAuthority),
+			SimulateMsgReplaceAuthority(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgScheduleUpgrade, DefaultWeightMsgScheduleUpgrade),
+			SimulateMsgScheduleUpgrade(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSetParameters, DefaultWeightMsgSetParameters),
+			SimulateMsgSetParameters(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgHaltTrading, DefaultWeightMsgHaltTrading),
+			SimulateMsgHaltTrading(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSetInstrumentConfig, DefaultWeightMsgSetInstrumentConfig),
+			SimulateMsgSetInstrumentConfig(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateIssuer generates a MsgCreateIssuer that makes a random account the issuer of a new denomination.
+func SimulateMsgCreateIssuer(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		authority, found := currentAuthority(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateIssuer, "authority not found"), nil, nil
+		}
+
+		issuer, _ := simtypes.RandomAcc(r, accs)
+		denom := "e" + strings.ToLower(simtypes.RandStringOfLength(r, 3+r.Intn(5)))
+
+		msg := &types.MsgCreateIssuer{
+			Authority: authority.Address.String(),
+			Issuer:    issuer.Address.String(),
+			Denominations: []types.Denomination{
+				{
+					Base:        denom,
+					Display:     strings.ToUpper(denom[1:]),
+					Description: fmt.Sprintf("Simulated %s stablecoin", denom),
+				},
+			},
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, authority, msg, nil)
+	}
+}
+
+// SimulateMsgDestroyIssuer generates a MsgDestroyIssuer for a random issuer.
+func SimulateMsgDestroyIssuer(
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, ik types.IssuerKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		authority, found := currentAuthority(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgDestroyIssuer, "authority not found"), nil, nil
+		}
+
+		issuers := ik.GetIssuers(ctx)
+		if len(issuers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgDestroyIssuer, "no issuer"), nil, nil
+		}
+
+		msg := &types.MsgDestroyIssuer{
+			Authority: authority.Address.String(),
+			Issuer:    issuers[r.Intn(len(issuers))].Address,
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, authority, msg, nil)
+	}
+}
+
+// SimulateMsgSetGasPrices generates a MsgSetGasPrices with small prices for a random selection of the denominations
+// in circulation. The minimum gas prices only apply to CheckTx, so they do not affect the delivery of simulated
+// transactions.
+func SimulateMsgSetGasPrices(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		authority, found := currentAuthority(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSetGasPrices, "authority not found"), nil, nil
+		}
+
+		supply, _, err := bk.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: math.MaxUint64})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSetGasPrices, err.Error()), nil, nil
+		}
+
+		gasPrices := sdk.NewDecCoins()
+		for _, coin := range supply {
+			if r.Intn(3) == 0 {
+				gasPrices = gasPrices.Add(sdk.NewDecCoinFromDec(coin.Denom, sdk.NewDecWithPrec(1+int64(r.Intn(100)), 6)))
+			}
+		}
+
+		msg := &types.MsgSetGasPrices{
+			Authority: authority.Address.String(),
+			GasPrices: gasPrices,
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, authority, msg, nil)
+	}
+}
+
+// SimulateMsgReplaceAuthority generates a MsgReplaceAuthority that hands the authority to a random account.
+func SimulateMsgReplaceAuthority(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		authority, found := currentAuthority(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgReplaceAuthority, "authority not found"), nil, nil
+		}
+
+		newAuthority, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgReplaceAuthority{
+			Authority:    authority.Address.String(),
+			NewAuthority: newAuthority.Address.String(),
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, authority, msg, nil)
+	}
+}
+
+// SimulateMsgScheduleUpgrade generates a MsgScheduleUpgrade for a height that the simulation never reaches, as the
+// upgrade handler does not exist and reaching it would halt the chain.
+func SimulateMsgScheduleUpgrade(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		authority, found := currentAuthority(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgScheduleUpgrade, "authority not found"), nil, nil
+		}
+
+		msg := &types.MsgScheduleUpgrade{
+			Authority: authority.Address.String(),
+			Plan: upgradetypes.Plan{
+				Name:   "sim-upgrade-" + strings.ToLower(simtypes.RandStringOfLength(r, 8)),
+				Height: ctx.BlockHeight() + 1_000_000 + int64(r.Intn(1_000_000)),
+			},
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, authority, msg, nil)
+	}
+}
+
+// SimulateMsgSetParameters generates a MsgSetParameters that changes the market fees.
+func SimulateMsgSetParameters(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		authority, found := currentAuthority(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSetParameters, "authority not found"), nil, nil
+		}
+
+		changes := make([]proposal.ParamChange, 0, 2)
+		for _, key := range [][]byte{markettypes.KeyMakerFee, markettypes.KeyTakerFee} {
+			if r.Intn(2) == 0 {
+				continue
+			}
+
+			fee := sdk.NewDecWithPrec(int64(r.Intn(101)), 4)
+			changes = append(changes, proposal.NewParamChange(markettypes.ModuleName, string(key), fmt.Sprintf("%q", fee.String())))
+		}
+		if len(changes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSetParameters, "no parameter changes"), nil, nil
+		}
+
+		msg := &types.MsgSetParameters{
+			Authority: authority.Address.String(),
+			Changes:   changes,
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, authority, msg, nil)
+	}
+}
+
+// SimulateMsgHaltTrading generates a MsgHaltTrading for a random instrument of the denominations in circulation and
+// schedules a MsgResumeTrading for it within the next few blocks.
+func SimulateMsgHaltTrading(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		authority, found := currentAuthority(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgHaltTrading, "authority not found"), nil, nil
+		}
+
+		supply, _, err := bk.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: math.MaxUint64})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgHaltTrading, err.Error()), nil, nil
+		}
+		if len(supply) < 2 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgHaltTrading, "not enough denominations"), nil, nil
+		}
+
+		perm := r.Perm(len(supply))
+		src, dst := supply[perm[0]].Denom, supply[perm[1]].Denom
+
+		msg := &types.MsgHaltTrading{
+			Authority:   authority.Address.String(),
+			Source:      src,
+			Destination: dst,
+		}
+
+		opMsg, futureOps, err := util.DeliverSimulatedMsg(r, app, ctx, ak, bk, authority, msg, nil, markettypes.ErrTradingHalted)
+		if err != nil || !opMsg.OK {
+			return opMsg, futureOps, err
+		}
+
+		futureOps = append(futureOps, simtypes.FutureOperation{
+			BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(10),
+			Op:          SimulateMsgResumeTrading(ak, bk, k, src, dst),
+		})
+		return opMsg, futureOps, nil
+	}
+}
+
+// SimulateMsgResumeTrading generates a MsgResumeTrading for the src/dst instrument.
+func SimulateMsgResumeTrading(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, src, dst string) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		authority, found := currentAuthority(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgResumeTrading, "authority not found"), nil, nil
+		}
+
+		msg := &types.MsgResumeTrading{
+			Authority:   authority.Address.String(),
+			Source:      src,
+			Destination: dst,
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, authority, msg, nil, markettypes.ErrTradingNotHalted)
+	}
+}
+
+// SimulateMsgSetInstrumentConfig generates a MsgSetInstrumentConfig for a random instrument of the denominations in
+// circulation and schedules a MsgRemoveInstrumentConfig for it within the next few blocks.
+func SimulateMsgSetInstrumentConfig(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		authority, found := currentAuthority(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSetInstrumentConfig, "authority not found"), nil, nil
+		}
+
+		supply, _, err := bk.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: math.MaxUint64})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSetInstrumentConfig, err.Error()), nil, nil
+		}
+		if len(supply) < 2 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSetInstrumentConfig, "not enough denominations"), nil, nil
+		}
+
+		perm := r.Perm(len(supply))
+		src, dst := supply[perm[0]].Denom, supply[perm[1]].Denom
+
+		// Mostly small restrictions, so that most of the random orders of the market module are still accepted.
+		msg := &types.MsgSetInstrumentConfig{
+			Authority:   authority.Address.String(),
+			Source:      src,
+			Destination: dst,
+			TickSize:    sdk.NewDecWithPrec(int64(r.Intn(2)), 4),
+			LotSize:     sdk.NewInt(int64(r.Intn(3))),
+			MinNotional: sdk.NewInt(int64(r.Intn(100))),
+		}
+
+		opMsg, futureOps, err := util.DeliverSimulatedMsg(r, app, ctx, ak, bk, authority, msg, nil)
+		if err != nil || !opMsg.OK {
+			return opMsg, futureOps, err
+		}
+
+		futureOps = append(futureOps, simtypes.FutureOperation{
+			BlockHeight: int(ctx.BlockHeight()) + 1 + r.Intn(10),
+			Op:          SimulateMsgRemoveInstrumentConfig(ak, bk, k, src, dst),
+		})
+		return opMsg, futureOps, nil
+	}
+}
+
+// SimulateMsgRemoveInstrumentConfig generates a MsgRemoveInstrumentConfig for the src/dst instrument.
+func SimulateMsgRemoveInstrumentConfig(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, src, dst string) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		authority, found := currentAuthority(ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgRemoveInstrumentConfig, "authority not found"), nil, nil
+		}
+
+		msg := &types.MsgRemoveInstrumentConfig{
+			Authority:   authority.Address.String(),
+			Source:      src,
+			Destination: dst,
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, authority, msg, nil)
+	}
+}
+
+// currentAuthority returns the simulation account of the current authority.
+func currentAuthority(ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, bool) {
+	address, err := sdk.AccAddressFromBech32(k.GetAuthoritySet(ctx).Address)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+
+	return simtypes.FindAccount(accs, address)
+}
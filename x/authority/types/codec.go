@@ -24,6 +24,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgReplaceAuthority{}, "e-money/MsgReplaceAuthority", nil)
 	cdc.RegisterConcrete(&MsgScheduleUpgrade{}, "e-money/MsgScheduleUpgrade", nil)
 	cdc.RegisterConcrete(&MsgSetParameters{}, "e-money/MsgSetParameters", nil)
+	cdc.RegisterConcrete(&MsgHaltTrading{}, "e-money/MsgHaltTrading", nil)
+	cdc.RegisterConcrete(&MsgResumeTrading{}, "e-money/MsgResumeTrading", nil)
+	cdc.RegisterConcrete(&MsgSetInstrumentConfig{}, "e-money/MsgSetInstrumentConfig", nil)
+	cdc.RegisterConcrete(&MsgRemoveInstrumentConfig{}, "e-money/MsgRemoveInstrumentConfig", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -34,6 +38,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgReplaceAuthority{},
 		&MsgScheduleUpgrade{},
 		&MsgSetParameters{},
+		&MsgHaltTrading{},
+		&MsgResumeTrading{},
+		&MsgSetInstrumentConfig{},
+		&MsgRemoveInstrumentConfig{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
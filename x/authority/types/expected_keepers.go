@@ -3,8 +3,10 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	params "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	issuertypes "github.com/e-money/em-ledger/x/issuer/types"
 )
 
 type (
@@ -12,8 +14,18 @@ type (
 		SetMinimumGasPrices(gasPricesStr string) error
 	}
 
+	AccountKeeper interface {
+		GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	}
+
 	BankKeeper interface {
 		GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
+		SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+		SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	}
+
+	IssuerKeeper interface {
+		GetIssuers(ctx sdk.Context) []issuertypes.Issuer
 	}
 
 	UpgradeKeeper interface {
@@ -27,4 +39,11 @@ type (
 	ParamsKeeper interface {
 		GetSubspace(name string) (ss params.Subspace, found bool)
 	}
+
+	MarketKeeper interface {
+		HaltTrading(ctx sdk.Context, src, dst string) error
+		ResumeTrading(ctx sdk.Context, src, dst string) error
+		SetInstrumentConfig(ctx sdk.Context, src, dst string, tickSize sdk.Dec, lotSize, minNotional sdk.Int) error
+		RemoveInstrumentConfig(ctx sdk.Context, src, dst string) error
+	}
 )
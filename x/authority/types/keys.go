@@ -11,6 +11,10 @@ const (
 	// Query endpoints supported by the authority querier
 	QueryGasPrices = "gasprices"
 
+	// Store keys of the authority set and the minimum gas prices
+	KeyAuthorityAccAddress = "AuthorityAccountAddress"
+	KeyGasPrices           = "GasPrices"
+
 	// AuthorityTransitionDuration is the period during which the former
 	// authority and new authority are in effect. During this period the former
 	// acts like a backup authority and cannot change till expiration.
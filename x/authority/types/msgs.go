@@ -13,6 +13,10 @@ var (
 	_ sdk.Msg = &MsgReplaceAuthority{}
 	_ sdk.Msg = &MsgScheduleUpgrade{}
 	_ sdk.Msg = &MsgSetParameters{}
+	_ sdk.Msg = &MsgHaltTrading{}
+	_ sdk.Msg = &MsgResumeTrading{}
+	_ sdk.Msg = &MsgSetInstrumentConfig{}
+	_ sdk.Msg = &MsgRemoveInstrumentConfig{}
 )
 
 func (msg MsgDestroyIssuer) Type() string { return "destroy_issuer" }
@@ -27,6 +31,14 @@ func (msg MsgScheduleUpgrade) Type() string { return "schedule_upgrade" }
 
 func (msg MsgSetParameters) Type() string { return "set_parameters" }
 
+func (msg MsgHaltTrading) Type() string { return "halt_trading" }
+
+func (msg MsgResumeTrading) Type() string { return "resume_trading" }
+
+func (msg MsgSetInstrumentConfig) Type() string { return "set_instrument_config" }
+
+func (msg MsgRemoveInstrumentConfig) Type() string { return "remove_instrument_config" }
+
 func (msg MsgDestroyIssuer) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid issuer address (%s)", err)
@@ -107,6 +119,66 @@ func (m MsgSetParameters) ValidateBasic() error {
 	return nil
 }
 
+func (msg MsgHaltTrading) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return validateInstrument(msg.Source, msg.Destination)
+}
+
+func (msg MsgResumeTrading) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return validateInstrument(msg.Source, msg.Destination)
+}
+
+func (msg MsgSetInstrumentConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if msg.TickSize.IsNil() || msg.TickSize.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "tick size must be at least zero: %v", msg.TickSize)
+	}
+
+	if msg.LotSize.IsNil() || msg.LotSize.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "lot size must be at least zero: %v", msg.LotSize)
+	}
+
+	if msg.MinNotional.IsNil() || msg.MinNotional.IsNegative() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "minimum notional must be at least zero: %v", msg.MinNotional)
+	}
+
+	return validateInstrument(msg.Source, msg.Destination)
+}
+
+func (msg MsgRemoveInstrumentConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	return validateInstrument(msg.Source, msg.Destination)
+}
+
+func validateInstrument(source, destination string) error {
+	if err := sdk.ValidateDenom(source); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "source: %v", err)
+	}
+
+	if err := sdk.ValidateDenom(destination); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "destination: %v", err)
+	}
+
+	if source == destination {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "source and destination are the same: %v", source)
+	}
+
+	return nil
+}
+
 func (msg MsgDestroyIssuer) GetSigners() []sdk.AccAddress {
 	from, err := sdk.AccAddressFromBech32(msg.Authority)
 	if err != nil {
@@ -155,6 +227,38 @@ func (msg MsgSetParameters) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+func (msg MsgHaltTrading) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgResumeTrading) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgSetInstrumentConfig) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgRemoveInstrumentConfig) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
 func (msg MsgDestroyIssuer) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
@@ -179,6 +283,22 @@ func (msg MsgSetParameters) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
 
+func (msg MsgHaltTrading) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgResumeTrading) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetInstrumentConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRemoveInstrumentConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
 func (msg MsgDestroyIssuer) Route() string { return ModuleName }
 
 func (msg MsgCreateIssuer) Route() string { return ModuleName }
@@ -190,3 +310,11 @@ func (msg MsgReplaceAuthority) Route() string { return ModuleName }
 func (msg MsgScheduleUpgrade) Route() string { return ModuleName }
 
 func (msg MsgSetParameters) Route() string { return ModuleName }
+
+func (msg MsgHaltTrading) Route() string { return ModuleName }
+
+func (msg MsgResumeTrading) Route() string { return ModuleName }
+
+func (msg MsgSetInstrumentConfig) Route() string { return ModuleName }
+
+func (msg MsgRemoveInstrumentConfig) Route() string { return ModuleName }
@@ -639,6 +639,400 @@ func (m *MsgSetParametersResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetParametersResponse proto.InternalMessageInfo
 
+// MsgHaltTrading suspends trading in both directions of a market instrument.
+type MsgHaltTrading struct {
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+}
+
+func (m *MsgHaltTrading) Reset()         { *m = MsgHaltTrading{} }
+func (m *MsgHaltTrading) String() string { return proto.CompactTextString(m) }
+func (*MsgHaltTrading) ProtoMessage()    {}
+func (*MsgHaltTrading) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{13}
+}
+func (m *MsgHaltTrading) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgHaltTrading) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgHaltTrading.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgHaltTrading) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHaltTrading.Merge(m, src)
+}
+func (m *MsgHaltTrading) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgHaltTrading) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHaltTrading.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgHaltTrading proto.InternalMessageInfo
+
+func (m *MsgHaltTrading) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgHaltTrading) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *MsgHaltTrading) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+type MsgHaltTradingResponse struct {
+}
+
+func (m *MsgHaltTradingResponse) Reset()         { *m = MsgHaltTradingResponse{} }
+func (m *MsgHaltTradingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgHaltTradingResponse) ProtoMessage()    {}
+func (*MsgHaltTradingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{14}
+}
+func (m *MsgHaltTradingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgHaltTradingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgHaltTradingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgHaltTradingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgHaltTradingResponse.Merge(m, src)
+}
+func (m *MsgHaltTradingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgHaltTradingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgHaltTradingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgHaltTradingResponse proto.InternalMessageInfo
+
+// MsgResumeTrading resumes trading in a market instrument that was halted by
+// the authority or by a circuit breaker.
+type MsgResumeTrading struct {
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+}
+
+func (m *MsgResumeTrading) Reset()         { *m = MsgResumeTrading{} }
+func (m *MsgResumeTrading) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeTrading) ProtoMessage()    {}
+func (*MsgResumeTrading) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{15}
+}
+func (m *MsgResumeTrading) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeTrading) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeTrading.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeTrading) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeTrading.Merge(m, src)
+}
+func (m *MsgResumeTrading) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeTrading) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeTrading.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeTrading proto.InternalMessageInfo
+
+func (m *MsgResumeTrading) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResumeTrading) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *MsgResumeTrading) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+type MsgResumeTradingResponse struct {
+}
+
+func (m *MsgResumeTradingResponse) Reset()         { *m = MsgResumeTradingResponse{} }
+func (m *MsgResumeTradingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeTradingResponse) ProtoMessage()    {}
+func (*MsgResumeTradingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{16}
+}
+func (m *MsgResumeTradingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeTradingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeTradingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeTradingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeTradingResponse.Merge(m, src)
+}
+func (m *MsgResumeTradingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeTradingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeTradingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeTradingResponse proto.InternalMessageInfo
+
+// MsgSetInstrumentConfig sets the tick size, lot size and minimum notional of
+// the orders that sell source for destination, replacing the module-wide
+// defaults for the instrument.
+type MsgSetInstrumentConfig struct {
+	Authority   string                                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Source      string                                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string                                 `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	TickSize    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size" yaml:"tick_size"`
+	LotSize     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=lot_size,json=lotSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lot_size" yaml:"lot_size"`
+	MinNotional github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_notional,json=minNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_notional" yaml:"min_notional"`
+}
+
+func (m *MsgSetInstrumentConfig) Reset()         { *m = MsgSetInstrumentConfig{} }
+func (m *MsgSetInstrumentConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInstrumentConfig) ProtoMessage()    {}
+func (*MsgSetInstrumentConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{17}
+}
+func (m *MsgSetInstrumentConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInstrumentConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInstrumentConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetInstrumentConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInstrumentConfig.Merge(m, src)
+}
+func (m *MsgSetInstrumentConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInstrumentConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInstrumentConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetInstrumentConfig proto.InternalMessageInfo
+
+func (m *MsgSetInstrumentConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetInstrumentConfig) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *MsgSetInstrumentConfig) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+type MsgSetInstrumentConfigResponse struct {
+}
+
+func (m *MsgSetInstrumentConfigResponse) Reset()         { *m = MsgSetInstrumentConfigResponse{} }
+func (m *MsgSetInstrumentConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetInstrumentConfigResponse) ProtoMessage()    {}
+func (*MsgSetInstrumentConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{18}
+}
+func (m *MsgSetInstrumentConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetInstrumentConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetInstrumentConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetInstrumentConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetInstrumentConfigResponse.Merge(m, src)
+}
+func (m *MsgSetInstrumentConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetInstrumentConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetInstrumentConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetInstrumentConfigResponse proto.InternalMessageInfo
+
+// MsgRemoveInstrumentConfig reverts an instrument to the module-wide defaults.
+type MsgRemoveInstrumentConfig struct {
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	Source      string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+}
+
+func (m *MsgRemoveInstrumentConfig) Reset()         { *m = MsgRemoveInstrumentConfig{} }
+func (m *MsgRemoveInstrumentConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveInstrumentConfig) ProtoMessage()    {}
+func (*MsgRemoveInstrumentConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{19}
+}
+func (m *MsgRemoveInstrumentConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveInstrumentConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveInstrumentConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveInstrumentConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveInstrumentConfig.Merge(m, src)
+}
+func (m *MsgRemoveInstrumentConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveInstrumentConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveInstrumentConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveInstrumentConfig proto.InternalMessageInfo
+
+func (m *MsgRemoveInstrumentConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveInstrumentConfig) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *MsgRemoveInstrumentConfig) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+type MsgRemoveInstrumentConfigResponse struct {
+}
+
+func (m *MsgRemoveInstrumentConfigResponse) Reset()         { *m = MsgRemoveInstrumentConfigResponse{} }
+func (m *MsgRemoveInstrumentConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveInstrumentConfigResponse) ProtoMessage()    {}
+func (*MsgRemoveInstrumentConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1601f633ca5d263c, []int{20}
+}
+func (m *MsgRemoveInstrumentConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveInstrumentConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveInstrumentConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveInstrumentConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveInstrumentConfigResponse.Merge(m, src)
+}
+func (m *MsgRemoveInstrumentConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveInstrumentConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveInstrumentConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveInstrumentConfigResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateIssuer)(nil), "em.authority.v1.MsgCreateIssuer")
 	proto.RegisterType((*Denomination)(nil), "em.authority.v1.Denomination")
@@ -653,61 +1047,88 @@ func init() {
 	proto.RegisterType((*MsgScheduleUpgradeResponse)(nil), "em.authority.v1.MsgScheduleUpgradeResponse")
 	proto.RegisterType((*MsgSetParameters)(nil), "em.authority.v1.MsgSetParameters")
 	proto.RegisterType((*MsgSetParametersResponse)(nil), "em.authority.v1.MsgSetParametersResponse")
+	proto.RegisterType((*MsgHaltTrading)(nil), "em.authority.v1.MsgHaltTrading")
+	proto.RegisterType((*MsgHaltTradingResponse)(nil), "em.authority.v1.MsgHaltTradingResponse")
+	proto.RegisterType((*MsgResumeTrading)(nil), "em.authority.v1.MsgResumeTrading")
+	proto.RegisterType((*MsgResumeTradingResponse)(nil), "em.authority.v1.MsgResumeTradingResponse")
+	proto.RegisterType((*MsgSetInstrumentConfig)(nil), "em.authority.v1.MsgSetInstrumentConfig")
+	proto.RegisterType((*MsgSetInstrumentConfigResponse)(nil), "em.authority.v1.MsgSetInstrumentConfigResponse")
+	proto.RegisterType((*MsgRemoveInstrumentConfig)(nil), "em.authority.v1.MsgRemoveInstrumentConfig")
+	proto.RegisterType((*MsgRemoveInstrumentConfigResponse)(nil), "em.authority.v1.MsgRemoveInstrumentConfigResponse")
 }
 
 func init() { proto.RegisterFile("em/authority/v1/tx.proto", fileDescriptor_1601f633ca5d263c) }
 
 var fileDescriptor_1601f633ca5d263c = []byte{
-	// 781 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0x8e, 0x49, 0x04, 0x62, 0x49, 0x04, 0x18, 0xaa, 0xba, 0x6e, 0x9a, 0x84, 0x2d, 0x07, 0x10,
-	0xc5, 0x56, 0xe8, 0xad, 0x52, 0x0f, 0x04, 0xaa, 0xd2, 0x43, 0x24, 0x64, 0xda, 0x0b, 0x52, 0x8b,
-	0x36, 0xf6, 0xd4, 0xb1, 0x1a, 0xff, 0xd4, 0xeb, 0x00, 0x79, 0x80, 0x4a, 0x55, 0x2f, 0xed, 0x73,
-	0xf4, 0x49, 0xb8, 0x54, 0x42, 0xea, 0xa5, 0xa7, 0xb4, 0x82, 0x37, 0xc8, 0x13, 0x54, 0xf6, 0xae,
-	0x1d, 0x3b, 0xb8, 0x02, 0xe5, 0xd0, 0x13, 0xde, 0x9d, 0xef, 0x9b, 0xfd, 0x76, 0x66, 0xf6, 0x23,
-	0x48, 0x02, 0x5b, 0x25, 0xfd, 0xa0, 0xeb, 0xfa, 0x56, 0x30, 0x50, 0x4f, 0x9b, 0x6a, 0x70, 0xae,
-	0x78, 0xbe, 0x1b, 0xb8, 0xe2, 0x22, 0xd8, 0x4a, 0x12, 0x51, 0x4e, 0x9b, 0xf2, 0xaa, 0xe9, 0x9a,
-	0x6e, 0x14, 0x53, 0xc3, 0x2f, 0x06, 0x93, 0x6b, 0xba, 0x4b, 0x6d, 0x97, 0xaa, 0x1d, 0x42, 0x41,
-	0x3d, 0x6d, 0x76, 0x20, 0x20, 0x4d, 0x55, 0x77, 0x2d, 0x87, 0xc7, 0xd7, 0x79, 0xbc, 0xef, 0x99,
-	0x3e, 0x31, 0xc6, 0x10, 0xbe, 0xe6, 0x28, 0xcc, 0x51, 0x1e, 0xf1, 0x89, 0x4d, 0x13, 0x10, 0x5b,
-	0x32, 0x0c, 0xfe, 0x29, 0xa0, 0xc5, 0x36, 0x35, 0xf7, 0x7c, 0x20, 0x01, 0xbc, 0xa2, 0xb4, 0x0f,
-	0xbe, 0xb8, 0x83, 0xe6, 0x13, 0x8d, 0x92, 0xd0, 0x10, 0x36, 0xe6, 0x5b, 0xab, 0xa3, 0x61, 0x7d,
-	0x69, 0x40, 0xec, 0xde, 0x33, 0x9c, 0x84, 0xb0, 0x36, 0x86, 0x89, 0x9b, 0x68, 0xd6, 0x8a, 0xd8,
-	0xd2, 0x4c, 0x44, 0x58, 0x1e, 0x0d, 0xeb, 0x15, 0x46, 0x60, 0xfb, 0x58, 0xe3, 0x00, 0x91, 0xa0,
-	0x8a, 0x01, 0x8e, 0x6b, 0x5b, 0x0e, 0x09, 0x2c, 0xd7, 0xa1, 0x52, 0xb1, 0x51, 0xdc, 0x58, 0xd8,
-	0x79, 0xa4, 0x4c, 0xd4, 0x46, 0xd9, 0x4f, 0xa1, 0x5a, 0xd5, 0x8b, 0x61, 0xbd, 0x30, 0x1a, 0xd6,
-	0x57, 0x59, 0xd2, 0x4c, 0x06, 0xac, 0x65, 0x33, 0xe2, 0x77, 0xa8, 0x9c, 0x26, 0x8b, 0x22, 0x2a,
-	0x85, 0xa5, 0x64, 0x97, 0xd1, 0xa2, 0x6f, 0x51, 0x42, 0x73, 0x86, 0x45, 0xbd, 0x1e, 0x19, 0x30,
-	0xc9, 0x5a, 0xbc, 0x14, 0x1b, 0x68, 0xc1, 0x00, 0xaa, 0xfb, 0x96, 0x17, 0x92, 0xa5, 0x62, 0x14,
-	0x4d, 0x6f, 0xe1, 0x07, 0xe8, 0xfe, 0x44, 0xd1, 0x34, 0xa0, 0x9e, 0xeb, 0x50, 0xc0, 0x1f, 0xd1,
-	0x52, 0x9b, 0x9a, 0xfb, 0x40, 0x03, 0xdf, 0x1d, 0xfc, 0x97, 0x82, 0x62, 0x19, 0x49, 0x93, 0x47,
-	0x26, 0x72, 0x7e, 0xb0, 0xfe, 0x1e, 0x41, 0xf0, 0x92, 0xd0, 0x43, 0xdf, 0xd2, 0x81, 0x4e, 0x25,
-	0xe7, 0x93, 0x80, 0x90, 0x49, 0xe8, 0x89, 0x17, 0xa5, 0x90, 0x66, 0xa2, 0x96, 0x55, 0x15, 0x36,
-	0x61, 0x4a, 0x58, 0x50, 0x85, 0xcf, 0x97, 0xb2, 0x0f, 0xfa, 0x9e, 0x6b, 0x39, 0xad, 0x03, 0xde,
-	0xb1, 0x65, 0x96, 0x77, 0xcc, 0xc6, 0xdf, 0x7f, 0xd7, 0xb7, 0x4c, 0x2b, 0xe8, 0xf6, 0x3b, 0x8a,
-	0xee, 0xda, 0x2a, 0x1f, 0x53, 0xf6, 0x67, 0x9b, 0x1a, 0x1f, 0xd4, 0x60, 0xe0, 0x01, 0x8d, 0x13,
-	0x51, 0x6d, 0xde, 0x8c, 0xb5, 0xf3, 0xca, 0xa7, 0xaf, 0x93, 0x5c, 0xf5, 0xb3, 0x80, 0x56, 0xda,
-	0xd4, 0xd4, 0xc0, 0xeb, 0x11, 0x1d, 0x76, 0x13, 0xe9, 0xd3, 0x5c, 0xf7, 0x39, 0xaa, 0x38, 0x70,
-	0x76, 0x32, 0xe6, 0xb1, 0x26, 0x48, 0xe3, 0x01, 0xcc, 0x84, 0xb1, 0x56, 0x76, 0xe0, 0x2c, 0x39,
-	0x12, 0x53, 0xf4, 0x30, 0x47, 0x49, 0xac, 0x54, 0x7c, 0x8d, 0xee, 0x65, 0xe8, 0x27, 0xc4, 0x30,
-	0x7c, 0xa0, 0x94, 0xab, 0x6b, 0x8c, 0x86, 0xf5, 0x6a, 0xce, 0x29, 0x31, 0x0c, 0x6b, 0x2b, 0xe9,
-	0xd3, 0x76, 0xf9, 0xee, 0x57, 0x01, 0x89, 0x61, 0x6d, 0xf4, 0x2e, 0x18, 0xfd, 0x1e, 0xbc, 0x61,
-	0x5e, 0x30, 0xd5, 0xf5, 0x5f, 0xa0, 0x92, 0xd7, 0x23, 0x4e, 0x74, 0xeb, 0x54, 0x9b, 0x63, 0x7b,
-	0x89, 0x3b, 0x7d, 0xd8, 0x23, 0x4e, 0x6b, 0x85, 0xb7, 0x79, 0x81, 0x25, 0x0c, 0x79, 0x58, 0x8b,
-	0xe8, 0xb8, 0x8a, 0xe4, 0x9b, 0x82, 0x92, 0x7e, 0x7d, 0x11, 0xa2, 0xa7, 0x72, 0x04, 0xc1, 0x61,
-	0xe8, 0x48, 0x10, 0x80, 0x3f, 0xdd, 0x6c, 0xb6, 0xd0, 0x9c, 0xde, 0x25, 0x8e, 0x99, 0xcc, 0x25,
-	0x8e, 0x05, 0x73, 0xab, 0x4b, 0xf4, 0x86, 0xcb, 0xbd, 0x08, 0xda, 0x2a, 0x85, 0xb2, 0xb5, 0x98,
-	0xc8, 0xdf, 0x50, 0x46, 0x4b, 0x2c, 0x74, 0xe7, 0xa2, 0x84, 0x8a, 0x6d, 0x6a, 0x8a, 0xc7, 0xa8,
-	0x9c, 0xf1, 0xc9, 0xc6, 0x0d, 0xc7, 0x9a, 0x30, 0x05, 0x79, 0xe3, 0x36, 0x44, 0x32, 0x12, 0x6f,
-	0x51, 0x25, 0xeb, 0x19, 0x6b, 0x79, 0xd4, 0x0c, 0x44, 0xde, 0xbc, 0x15, 0x92, 0xa4, 0x3f, 0x46,
-	0xe5, 0x8c, 0x05, 0xe4, 0x4a, 0x4f, 0x23, 0xf2, 0xa5, 0xe7, 0xbd, 0x3b, 0xf1, 0x3d, 0x5a, 0xba,
-	0xf1, 0xe6, 0xd6, 0xf3, 0xd8, 0x93, 0x28, 0xf9, 0xc9, 0x5d, 0x50, 0xc9, 0x39, 0x3a, 0x5a, 0x9c,
-	0x9c, 0xed, 0xc7, 0xb9, 0x22, 0xb3, 0x20, 0x79, 0xeb, 0x0e, 0xa0, 0x74, 0x1f, 0xb2, 0x03, 0xb9,
-	0xf6, 0x8f, 0x3a, 0x8c, 0x21, 0xf9, 0x7d, 0xc8, 0x1d, 0xa5, 0xd6, 0xc1, 0xc5, 0x55, 0x4d, 0xb8,
-	0xbc, 0xaa, 0x09, 0x7f, 0xae, 0x6a, 0xc2, 0xb7, 0xeb, 0x5a, 0xe1, 0xf2, 0xba, 0x56, 0xf8, 0x75,
-	0x5d, 0x2b, 0x1c, 0x2b, 0x29, 0x43, 0x84, 0x6d, 0xdb, 0x75, 0x60, 0xa0, 0x82, 0xbd, 0xdd, 0x03,
-	0xc3, 0x04, 0x5f, 0x3d, 0x4f, 0xfd, 0x9e, 0x88, 0xcc, 0xb1, 0x33, 0x1b, 0xfd, 0xff, 0x7e, 0xfa,
-	0x37, 0x00, 0x00, 0xff, 0xff, 0xc6, 0xb4, 0x26, 0x2c, 0x6c, 0x08, 0x00, 0x00,
+	// 1077 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x4f, 0xdc, 0xc6,
+	0x1b, 0xc6, 0x81, 0x1f, 0x84, 0x01, 0x7e, 0x10, 0x43, 0xa9, 0xe3, 0xd2, 0xdd, 0x65, 0x12, 0x35,
+	0xd0, 0x14, 0x5b, 0xd0, 0x4b, 0x55, 0xa9, 0x07, 0x16, 0xa2, 0xc2, 0x81, 0x0a, 0x99, 0x54, 0x95,
+	0x50, 0xdb, 0xd5, 0x60, 0x4f, 0x8c, 0x15, 0x7b, 0xc6, 0xf5, 0xcc, 0x12, 0x36, 0xf7, 0x4a, 0x55,
+	0x2f, 0xed, 0xd7, 0x68, 0x7b, 0xed, 0x87, 0xc8, 0xa5, 0x52, 0xa4, 0x5e, 0xaa, 0x1c, 0xb6, 0x15,
+	0x1c, 0x7b, 0xdb, 0x4f, 0x50, 0x79, 0xc6, 0x9e, 0xb5, 0x17, 0xa3, 0x25, 0x7b, 0xa8, 0xd2, 0xd3,
+	0x7a, 0x3c, 0xcf, 0xfb, 0xbc, 0xcf, 0xfb, 0xc7, 0xef, 0xcc, 0x02, 0x03, 0x47, 0x36, 0x6a, 0xf3,
+	0x53, 0x9a, 0x04, 0xbc, 0x63, 0x9f, 0x6d, 0xda, 0xfc, 0xdc, 0x8a, 0x13, 0xca, 0xa9, 0x3e, 0x8f,
+	0x23, 0x4b, 0xed, 0x58, 0x67, 0x9b, 0xe6, 0x92, 0x4f, 0x7d, 0x2a, 0xf6, 0xec, 0xf4, 0x49, 0xc2,
+	0xcc, 0x9a, 0x4b, 0x59, 0x44, 0x99, 0x7d, 0x82, 0x18, 0xb6, 0xcf, 0x36, 0x4f, 0x30, 0x47, 0x9b,
+	0xb6, 0x4b, 0x03, 0x92, 0xed, 0xdf, 0xcf, 0xf6, 0xdb, 0xb1, 0x9f, 0x20, 0xaf, 0x0f, 0xc9, 0xd6,
+	0x19, 0x0a, 0x66, 0xa8, 0x18, 0x25, 0x28, 0x62, 0x0a, 0x24, 0x97, 0x12, 0x03, 0x7f, 0xd7, 0xc0,
+	0xfc, 0x01, 0xf3, 0x77, 0x12, 0x8c, 0x38, 0xde, 0x67, 0xac, 0x8d, 0x13, 0x7d, 0x0b, 0x4c, 0x2b,
+	0x8d, 0x86, 0xd6, 0xd0, 0xd6, 0xa6, 0x9b, 0x4b, 0xbd, 0x6e, 0x7d, 0xa1, 0x83, 0xa2, 0xf0, 0x63,
+	0xa8, 0xb6, 0xa0, 0xd3, 0x87, 0xe9, 0xeb, 0x60, 0x32, 0x10, 0xd6, 0xc6, 0x2d, 0x61, 0x70, 0xa7,
+	0xd7, 0xad, 0xcf, 0x49, 0x03, 0xf9, 0x1e, 0x3a, 0x19, 0x40, 0x47, 0x60, 0xce, 0xc3, 0x84, 0x46,
+	0x01, 0x41, 0x3c, 0xa0, 0x84, 0x19, 0xe3, 0x8d, 0xf1, 0xb5, 0x99, 0xad, 0x77, 0xad, 0x81, 0xdc,
+	0x58, 0xbb, 0x05, 0x54, 0x73, 0xe5, 0x45, 0xb7, 0x3e, 0xd6, 0xeb, 0xd6, 0x97, 0x24, 0x69, 0x89,
+	0x01, 0x3a, 0x65, 0x46, 0xf8, 0x35, 0x98, 0x2d, 0x1a, 0xeb, 0x3a, 0x98, 0x48, 0x53, 0x29, 0x83,
+	0x71, 0xc4, 0xb3, 0x6e, 0x80, 0x29, 0x2f, 0x60, 0x71, 0x88, 0x3a, 0x52, 0xb2, 0x93, 0x2f, 0xf5,
+	0x06, 0x98, 0xf1, 0x30, 0x73, 0x93, 0x20, 0x4e, 0x8d, 0x8d, 0x71, 0xb1, 0x5b, 0x7c, 0x05, 0xef,
+	0x82, 0xb7, 0x07, 0x92, 0xe6, 0x60, 0x16, 0x53, 0xc2, 0x30, 0xfc, 0x06, 0x2c, 0x1c, 0x30, 0x7f,
+	0x17, 0x33, 0x9e, 0xd0, 0xce, 0xbf, 0x92, 0x50, 0x68, 0x02, 0x63, 0xd0, 0xa5, 0x92, 0xf3, 0x9b,
+	0xac, 0xef, 0x11, 0xe6, 0x9f, 0x22, 0x76, 0x98, 0x04, 0x2e, 0x66, 0x23, 0xc9, 0xf9, 0x56, 0x03,
+	0xc0, 0x47, 0xac, 0x15, 0x0b, 0x0a, 0xe3, 0x96, 0x28, 0xd9, 0x8a, 0x25, 0x3b, 0xcc, 0x4a, 0x13,
+	0x6a, 0x65, 0xfd, 0x65, 0xed, 0x62, 0x77, 0x87, 0x06, 0xa4, 0xb9, 0x97, 0x55, 0xec, 0x8e, 0xe4,
+	0xed, 0x5b, 0xc3, 0x9f, 0xff, 0xac, 0x3f, 0xf4, 0x03, 0x7e, 0xda, 0x3e, 0xb1, 0x5c, 0x1a, 0xd9,
+	0x59, 0x9b, 0xca, 0x9f, 0x0d, 0xe6, 0x3d, 0xb5, 0x79, 0x27, 0xc6, 0x2c, 0x27, 0x62, 0xce, 0xb4,
+	0x9f, 0x6b, 0xcf, 0x32, 0x5f, 0x0c, 0x47, 0x85, 0xfa, 0x9d, 0x06, 0x16, 0x0f, 0x98, 0xef, 0xe0,
+	0x38, 0x44, 0x2e, 0xde, 0x56, 0xd2, 0x47, 0x09, 0xf7, 0x13, 0x30, 0x47, 0xf0, 0xb3, 0x56, 0xdf,
+	0x4e, 0x16, 0xc1, 0xe8, 0x37, 0x60, 0x69, 0x1b, 0x3a, 0xb3, 0x04, 0x3f, 0x53, 0x2e, 0x21, 0x03,
+	0xef, 0x54, 0x28, 0xc9, 0x95, 0xea, 0x8f, 0xc1, 0x5b, 0x25, 0xf3, 0x16, 0xf2, 0xbc, 0x04, 0x33,
+	0x96, 0xa9, 0x6b, 0xf4, 0xba, 0xf5, 0x95, 0x0a, 0x2f, 0x39, 0x0c, 0x3a, 0x8b, 0x45, 0x6f, 0xdb,
+	0xd9, 0xdb, 0x1f, 0x34, 0xa0, 0xa7, 0xb9, 0x71, 0x4f, 0xb1, 0xd7, 0x0e, 0xf1, 0xe7, 0x72, 0x16,
+	0x8c, 0x14, 0xfe, 0x23, 0x30, 0x11, 0x87, 0x88, 0x88, 0xa8, 0x0b, 0x65, 0xce, 0xc7, 0x4b, 0x5e,
+	0xe9, 0xc3, 0x10, 0x91, 0xe6, 0x62, 0x56, 0xe6, 0x19, 0x49, 0x98, 0xda, 0x41, 0x47, 0x98, 0xc3,
+	0x15, 0x60, 0x5e, 0x15, 0xa4, 0xea, 0xf5, 0xbd, 0x26, 0x3e, 0x95, 0x23, 0xcc, 0x0f, 0xd3, 0x89,
+	0x84, 0x39, 0x4e, 0x46, 0xeb, 0xcd, 0x26, 0x98, 0x72, 0x4f, 0x11, 0xf1, 0x55, 0x5f, 0xc2, 0x5c,
+	0x70, 0x36, 0xea, 0x94, 0xde, 0x74, 0xb9, 0x23, 0xa0, 0xcd, 0x89, 0x54, 0xb6, 0x93, 0x1b, 0x66,
+	0xdf, 0x50, 0x49, 0x8b, 0x12, 0xfa, 0x93, 0x06, 0xfe, 0x7f, 0xc0, 0xfc, 0x3d, 0x14, 0xf2, 0xc7,
+	0x09, 0xf2, 0x02, 0xe2, 0x8f, 0xfa, 0x45, 0x33, 0xda, 0x4e, 0x5c, 0x7c, 0xf5, 0x8b, 0x96, 0xef,
+	0xa1, 0x93, 0x01, 0xf4, 0x8f, 0xc4, 0x04, 0xe2, 0xd9, 0xf8, 0x92, 0x13, 0xa8, 0xb9, 0xdc, 0xeb,
+	0xd6, 0xf5, 0x7c, 0xfa, 0xa9, 0x4d, 0xe8, 0x14, 0xa1, 0xd0, 0x00, 0xcb, 0x65, 0xa9, 0x2a, 0x8a,
+	0x5f, 0x64, 0xba, 0x1d, 0xcc, 0xda, 0x11, 0x7e, 0xe3, 0xe3, 0x90, 0xf5, 0x28, 0x89, 0x55, 0x91,
+	0xbc, 0x1a, 0x17, 0x41, 0x1e, 0x61, 0xbe, 0x4f, 0x18, 0x4f, 0xda, 0x11, 0x26, 0x7c, 0x87, 0x92,
+	0x27, 0xc1, 0x9b, 0x1b, 0x8f, 0xde, 0x02, 0xd3, 0x3c, 0x70, 0x9f, 0xb6, 0x58, 0xf0, 0x1c, 0x1b,
+	0x13, 0xc2, 0xae, 0x99, 0x76, 0xe0, 0xab, 0x6e, 0xfd, 0xbd, 0x9b, 0x8d, 0xc2, 0x7e, 0x18, 0x8a,
+	0x08, 0x3a, 0xb7, 0xd3, 0xe7, 0xa3, 0xe0, 0x39, 0xd6, 0xbf, 0x04, 0xb7, 0x43, 0xca, 0x25, 0xff,
+	0xff, 0x04, 0xff, 0xf6, 0x6b, 0xf0, 0xef, 0x13, 0xde, 0xeb, 0xd6, 0xe7, 0x25, 0x7f, 0xce, 0x03,
+	0x9d, 0xa9, 0x90, 0x72, 0xc1, 0x7e, 0x0a, 0x66, 0xa3, 0x80, 0xb4, 0x08, 0x4d, 0x83, 0x41, 0xa1,
+	0x31, 0x29, 0x3c, 0x3c, 0x7a, 0x6d, 0x0f, 0x8b, 0xd2, 0x43, 0x91, 0x0b, 0x3a, 0x33, 0x51, 0x40,
+	0x3e, 0xcb, 0x57, 0x0d, 0x50, 0xab, 0xae, 0xad, 0x2a, 0xff, 0xaf, 0x1a, 0xb8, 0x2b, 0x7a, 0x23,
+	0xa2, 0x67, 0xf8, 0x3f, 0xd3, 0x01, 0xf0, 0x1e, 0x58, 0xbd, 0x56, 0x75, 0x1e, 0xdb, 0xd6, 0xdf,
+	0x53, 0x60, 0xfc, 0x80, 0xf9, 0xfa, 0x31, 0x98, 0x2d, 0x5d, 0xc9, 0x1a, 0x57, 0x2e, 0x47, 0x03,
+	0xf7, 0x0f, 0x73, 0x6d, 0x18, 0x42, 0x9d, 0x3e, 0x5f, 0x81, 0xb9, 0xf2, 0xf5, 0x64, 0xb5, 0xca,
+	0xb4, 0x04, 0x31, 0xd7, 0x87, 0x42, 0x14, 0xfd, 0x31, 0x98, 0x2d, 0xdd, 0x36, 0x2a, 0xa5, 0x17,
+	0x11, 0xe6, 0xda, 0x30, 0x84, 0xe2, 0x7e, 0x02, 0x16, 0xae, 0x1c, 0xef, 0xf7, 0xab, 0xac, 0x07,
+	0x51, 0xe6, 0x07, 0x37, 0x41, 0x29, 0x3f, 0x2e, 0x98, 0x1f, 0x3c, 0x46, 0xef, 0x55, 0x8a, 0x2c,
+	0x83, 0xcc, 0x87, 0x37, 0x00, 0x15, 0xeb, 0x50, 0x3e, 0xfb, 0x56, 0xaf, 0xc9, 0x43, 0x1f, 0x62,
+	0xae, 0x0f, 0x85, 0x28, 0xfa, 0x2f, 0xc0, 0x4c, 0xf1, 0xc4, 0xaa, 0x57, 0x59, 0x16, 0x00, 0xe6,
+	0x83, 0x21, 0x80, 0xa2, 0xee, 0xf2, 0x21, 0xb2, 0x5a, 0x9d, 0xdb, 0x02, 0xc4, 0x5c, 0x1f, 0x0a,
+	0x51, 0xf4, 0x14, 0x2c, 0x56, 0x4d, 0xf6, 0x07, 0xd7, 0x44, 0x3e, 0x08, 0x34, 0xed, 0x1b, 0x02,
+	0x95, 0xc3, 0x73, 0xb0, 0x7c, 0xcd, 0x2c, 0x79, 0xbf, 0x5a, 0x75, 0x15, 0xd6, 0xdc, 0xba, 0x39,
+	0x36, 0xf7, 0xdc, 0xdc, 0x7b, 0x71, 0x51, 0xd3, 0x5e, 0x5e, 0xd4, 0xb4, 0xbf, 0x2e, 0x6a, 0xda,
+	0x8f, 0x97, 0xb5, 0xb1, 0x97, 0x97, 0xb5, 0xb1, 0x3f, 0x2e, 0x6b, 0x63, 0xc7, 0x56, 0x61, 0xa2,
+	0xe2, 0x8d, 0x88, 0x12, 0xdc, 0xb1, 0x71, 0xb4, 0x11, 0x62, 0xcf, 0xc7, 0x89, 0x7d, 0x5e, 0xf8,
+	0x77, 0x29, 0xa6, 0xeb, 0xc9, 0xa4, 0xf8, 0x37, 0xf7, 0xe1, 0x3f, 0x03, 0x00, 0x2a, 0xa0, 0xb4,
+	0xc5, 0x7a, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -728,6 +1149,10 @@ type MsgClient interface {
 	ReplaceAuthority(ctx context.Context, in *MsgReplaceAuthority, opts ...grpc.CallOption) (*MsgReplaceAuthorityResponse, error)
 	ScheduleUpgrade(ctx context.Context, in *MsgScheduleUpgrade, opts ...grpc.CallOption) (*MsgScheduleUpgradeResponse, error)
 	SetParameters(ctx context.Context, in *MsgSetParameters, opts ...grpc.CallOption) (*MsgSetParametersResponse, error)
+	HaltTrading(ctx context.Context, in *MsgHaltTrading, opts ...grpc.CallOption) (*MsgHaltTradingResponse, error)
+	ResumeTrading(ctx context.Context, in *MsgResumeTrading, opts ...grpc.CallOption) (*MsgResumeTradingResponse, error)
+	SetInstrumentConfig(ctx context.Context, in *MsgSetInstrumentConfig, opts ...grpc.CallOption) (*MsgSetInstrumentConfigResponse, error)
+	RemoveInstrumentConfig(ctx context.Context, in *MsgRemoveInstrumentConfig, opts ...grpc.CallOption) (*MsgRemoveInstrumentConfigResponse, error)
 }
 
 type msgClient struct {
@@ -792,6 +1217,42 @@ func (c *msgClient) SetParameters(ctx context.Context, in *MsgSetParameters, opt
 	return out, nil
 }
 
+func (c *msgClient) HaltTrading(ctx context.Context, in *MsgHaltTrading, opts ...grpc.CallOption) (*MsgHaltTradingResponse, error) {
+	out := new(MsgHaltTradingResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/HaltTrading", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeTrading(ctx context.Context, in *MsgResumeTrading, opts ...grpc.CallOption) (*MsgResumeTradingResponse, error) {
+	out := new(MsgResumeTradingResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/ResumeTrading", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetInstrumentConfig(ctx context.Context, in *MsgSetInstrumentConfig, opts ...grpc.CallOption) (*MsgSetInstrumentConfigResponse, error) {
+	out := new(MsgSetInstrumentConfigResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/SetInstrumentConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveInstrumentConfig(ctx context.Context, in *MsgRemoveInstrumentConfig, opts ...grpc.CallOption) (*MsgRemoveInstrumentConfigResponse, error) {
+	out := new(MsgRemoveInstrumentConfigResponse)
+	err := c.cc.Invoke(ctx, "/em.authority.v1.Msg/RemoveInstrumentConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateIssuer(context.Context, *MsgCreateIssuer) (*MsgCreateIssuerResponse, error)
@@ -800,6 +1261,10 @@ type MsgServer interface {
 	ReplaceAuthority(context.Context, *MsgReplaceAuthority) (*MsgReplaceAuthorityResponse, error)
 	ScheduleUpgrade(context.Context, *MsgScheduleUpgrade) (*MsgScheduleUpgradeResponse, error)
 	SetParameters(context.Context, *MsgSetParameters) (*MsgSetParametersResponse, error)
+	HaltTrading(context.Context, *MsgHaltTrading) (*MsgHaltTradingResponse, error)
+	ResumeTrading(context.Context, *MsgResumeTrading) (*MsgResumeTradingResponse, error)
+	SetInstrumentConfig(context.Context, *MsgSetInstrumentConfig) (*MsgSetInstrumentConfigResponse, error)
+	RemoveInstrumentConfig(context.Context, *MsgRemoveInstrumentConfig) (*MsgRemoveInstrumentConfigResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -824,6 +1289,18 @@ func (*UnimplementedMsgServer) ScheduleUpgrade(ctx context.Context, req *MsgSche
 func (*UnimplementedMsgServer) SetParameters(ctx context.Context, req *MsgSetParameters) (*MsgSetParametersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetParameters not implemented")
 }
+func (*UnimplementedMsgServer) HaltTrading(ctx context.Context, req *MsgHaltTrading) (*MsgHaltTradingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HaltTrading not implemented")
+}
+func (*UnimplementedMsgServer) ResumeTrading(ctx context.Context, req *MsgResumeTrading) (*MsgResumeTradingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeTrading not implemented")
+}
+func (*UnimplementedMsgServer) SetInstrumentConfig(ctx context.Context, req *MsgSetInstrumentConfig) (*MsgSetInstrumentConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInstrumentConfig not implemented")
+}
+func (*UnimplementedMsgServer) RemoveInstrumentConfig(ctx context.Context, req *MsgRemoveInstrumentConfig) (*MsgRemoveInstrumentConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveInstrumentConfig not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -937,6 +1414,78 @@ func _Msg_SetParameters_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_HaltTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHaltTrading)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HaltTrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/HaltTrading",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HaltTrading(ctx, req.(*MsgHaltTrading))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeTrading)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeTrading(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/ResumeTrading",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeTrading(ctx, req.(*MsgResumeTrading))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetInstrumentConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetInstrumentConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetInstrumentConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/SetInstrumentConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetInstrumentConfig(ctx, req.(*MsgSetInstrumentConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveInstrumentConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveInstrumentConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveInstrumentConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/em.authority.v1.Msg/RemoveInstrumentConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveInstrumentConfig(ctx, req.(*MsgRemoveInstrumentConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "em.authority.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -965,6 +1514,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetParameters",
 			Handler:    _Msg_SetParameters_Handler,
 		},
+		{
+			MethodName: "HaltTrading",
+			Handler:    _Msg_HaltTrading_Handler,
+		},
+		{
+			MethodName: "ResumeTrading",
+			Handler:    _Msg_ResumeTrading_Handler,
+		},
+		{
+			MethodName: "SetInstrumentConfig",
+			Handler:    _Msg_SetInstrumentConfig_Handler,
+		},
+		{
+			MethodName: "RemoveInstrumentConfig",
+			Handler:    _Msg_RemoveInstrumentConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "em/authority/v1/tx.proto",
@@ -1412,170 +1977,360 @@ func (m *MsgSetParametersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgHaltTrading) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateIssuer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Denominations) > 0 {
-		for _, e := range m.Denominations {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+
+func (m *MsgHaltTrading) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Denomination) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgHaltTrading) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Base)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Display)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateIssuerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgHaltTradingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDestroyIssuer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgHaltTradingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDestroyIssuerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgHaltTradingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetGasPrices) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.GasPrices) > 0 {
-		for _, e := range m.GasPrices {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgResumeTrading) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSetGasPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgResumeTrading) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgReplaceAuthority) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgResumeTrading) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.NewAuthority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgReplaceAuthorityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgResumeTradingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeTradingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeTradingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NewAuthorityAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgScheduleUpgrade) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetInstrumentConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInstrumentConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInstrumentConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinNotional.Size()
+		i -= size
+		if _, err := m.MinNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LotSize.Size()
+		i -= size
+		if _, err := m.LotSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetInstrumentConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetInstrumentConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetInstrumentConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveInstrumentConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveInstrumentConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveInstrumentConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveInstrumentConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveInstrumentConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveInstrumentConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Authority)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Plan.Size()
-	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denominations) > 0 {
+		for _, e := range m.Denominations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *MsgScheduleUpgradeResponse) Size() (n int) {
+func (m *Denomination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Display)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateIssuerResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1584,7 +2339,7 @@ func (m *MsgScheduleUpgradeResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgSetParameters) Size() (n int) {
+func (m *MsgDestroyIssuer) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1594,31 +2349,1053 @@ func (m *MsgSetParameters) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.Changes) > 0 {
-		for _, e := range m.Changes {
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDestroyIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetGasPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.GasPrices) > 0 {
+		for _, e := range m.GasPrices {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgSetGasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReplaceAuthority) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewAuthority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReplaceAuthorityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewAuthorityAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgScheduleUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Plan.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleUpgradeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetParametersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgHaltTrading) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgHaltTradingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeTrading) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeTradingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetInstrumentConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TickSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LotSize.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinNotional.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetInstrumentConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveInstrumentConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveInstrumentConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denominations = append(m.Denominations, Denomination{})
+			if err := m.Denominations[len(m.Denominations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Denomination) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Denomination: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Denomination: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Display = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDestroyIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDestroyIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDestroyIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDestroyIssuerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDestroyIssuerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDestroyIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetGasPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetGasPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasPrices = append(m.GasPrices, types.DecCoin{})
+			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetGasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetGasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReplaceAuthority) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReplaceAuthority: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReplaceAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgSetParametersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
+func (m *MsgReplaceAuthorityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1641,15 +3418,15 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIssuer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReplaceAuthorityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReplaceAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthorityAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1677,11 +3454,61 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.NewAuthorityAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1709,11 +3536,11 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denominations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1740,8 +3567,7 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denominations = append(m.Denominations, Denomination{})
-			if err := m.Denominations[len(m.Denominations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1766,7 +3592,7 @@ func (m *MsgCreateIssuer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Denomination) Unmarshal(dAtA []byte) error {
+func (m *MsgScheduleUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1789,47 +3615,65 @@ func (m *Denomination) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Denomination: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgScheduleUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Denomination: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgScheduleUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Base = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Display", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1857,13 +3701,13 @@ func (m *Denomination) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Display = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1873,23 +3717,25 @@ func (m *Denomination) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Changes = append(m.Changes, proposal.ParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1912,7 +3758,7 @@ func (m *Denomination) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateIssuerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetParametersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1935,10 +3781,10 @@ func (m *MsgCreateIssuerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIssuerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetParametersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetParametersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1962,7 +3808,7 @@ func (m *MsgCreateIssuerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDestroyIssuer) Unmarshal(dAtA []byte) error {
+func (m *MsgHaltTrading) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1985,15 +3831,47 @@ func (m *MsgDestroyIssuer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDestroyIssuer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgHaltTrading: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDestroyIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgHaltTrading: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2021,11 +3899,11 @@ func (m *MsgDestroyIssuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2053,7 +3931,7 @@ func (m *MsgDestroyIssuer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2076,7 +3954,7 @@ func (m *MsgDestroyIssuer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDestroyIssuerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgHaltTradingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2099,10 +3977,10 @@ func (m *MsgDestroyIssuerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDestroyIssuerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgHaltTradingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDestroyIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgHaltTradingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2126,7 +4004,7 @@ func (m *MsgDestroyIssuerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetGasPrices) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeTrading) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2149,10 +4027,10 @@ func (m *MsgSetGasPrices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetGasPrices: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeTrading: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetGasPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeTrading: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2189,9 +4067,9 @@ func (m *MsgSetGasPrices) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2201,25 +4079,55 @@ func (m *MsgSetGasPrices) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GasPrices = append(m.GasPrices, types.DecCoin{})
-			if err := m.GasPrices[len(m.GasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2242,7 +4150,7 @@ func (m *MsgSetGasPrices) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetGasPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeTradingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2265,10 +4173,10 @@ func (m *MsgSetGasPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetGasPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeTradingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetGasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeTradingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2292,7 +4200,7 @@ func (m *MsgSetGasPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReplaceAuthority) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInstrumentConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2315,10 +4223,10 @@ func (m *MsgReplaceAuthority) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReplaceAuthority: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInstrumentConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReplaceAuthority: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInstrumentConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2355,7 +4263,7 @@ func (m *MsgReplaceAuthority) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2383,61 +4291,11 @@ func (m *MsgReplaceAuthority) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAuthority = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgReplaceAuthorityResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReplaceAuthorityResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReplaceAuthorityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthorityAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2465,61 +4323,45 @@ func (m *MsgReplaceAuthorityResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAuthorityAddress = string(dAtA[iNdEx:postIndex])
+			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgScheduleUpgrade) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgScheduleUpgrade: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgScheduleUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2547,13 +4389,15 @@ func (m *MsgScheduleUpgrade) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			if err := m.LotSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNotional", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2563,22 +4407,23 @@ func (m *MsgScheduleUpgrade) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2603,7 +4448,7 @@ func (m *MsgScheduleUpgrade) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgScheduleUpgradeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetInstrumentConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2626,10 +4471,10 @@ func (m *MsgScheduleUpgradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgScheduleUpgradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetInstrumentConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgScheduleUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetInstrumentConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2653,7 +4498,7 @@ func (m *MsgScheduleUpgradeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetParameters) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveInstrumentConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2676,10 +4521,10 @@ func (m *MsgSetParameters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetParameters: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveInstrumentConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveInstrumentConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2716,9 +4561,9 @@ func (m *MsgSetParameters) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2728,25 +4573,55 @@ func (m *MsgSetParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Changes = append(m.Changes, proposal.ParamChange{})
-			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2769,7 +4644,7 @@ func (m *MsgSetParameters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetParametersResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveInstrumentConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2792,10 +4667,10 @@ func (m *MsgSetParametersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetParametersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveInstrumentConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetParametersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveInstrumentConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/e-money/em-ledger/x/buyback/internal/types"
+	ptypes "github.com/gogo/protobuf/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's Value to the time of the last buyback
+// or the buyback interval.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.GetLastUpdatedKey()):
+			var lastUpdatedA, lastUpdatedB ptypes.Timestamp
+			cdc.MustUnmarshalLengthPrefixed(kvA.Value, &lastUpdatedA)
+			cdc.MustUnmarshalLengthPrefixed(kvB.Value, &lastUpdatedB)
+			return fmt.Sprintf("%v\n%v", lastUpdatedA, lastUpdatedB)
+
+		case bytes.Equal(kvA.Key, types.GetUpdateIntervalKey()):
+			var intervalA, intervalB ptypes.Duration
+			cdc.MustUnmarshal(kvA.Value, &intervalA)
+			cdc.MustUnmarshal(kvB.Value, &intervalB)
+			return fmt.Sprintf("%v\n%v", intervalA, intervalB)
+
+		default:
+			panic(fmt.Sprintf("invalid buyback key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/e-money/em-ledger/x/buyback/internal/types"
+)
+
+// Simulation parameter constants
+const Interval = "interval"
+
+// GenInterval returns a random buyback interval between one minute and two hours.
+func GenInterval(r *rand.Rand) time.Duration {
+	return time.Duration(1+r.Intn(120)) * time.Minute
+}
+
+// RandomizedGenState generates a random GenesisState for the buyback module.
+func RandomizedGenState(simState *module.SimulationState) {
+	var interval time.Duration
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, Interval, &interval, simState.Rand,
+		func(r *rand.Rand) { interval = GenInterval(r) },
+	)
+
+	buybackGenesis := types.GenesisState{
+		Interval: interval.String(),
+	}
+
+	fmt.Printf("Selected randomly generated buyback interval: %s\n", buybackGenesis.Interval)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&buybackGenesis)
+}
@@ -0,0 +1,25 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/e-money/em-ledger/x/inflation/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's Value to the inflation state.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, types.MinterKey):
+			var stateA, stateB types.InflationState
+			cdc.MustUnmarshalLengthPrefixed(kvA.Value, &stateA)
+			cdc.MustUnmarshalLengthPrefixed(kvB.Value, &stateB)
+			return fmt.Sprintf("%v\n%v", stateA, stateB)
+		default:
+			panic(fmt.Sprintf("invalid inflation key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/e-money/em-ledger/x/inflation/types"
+	issuertypes "github.com/e-money/em-ledger/x/issuer/types"
+)
+
+// GenInflation returns a random annual inflation of at most 10%.
+func GenInflation(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 3)
+}
+
+// RandomizedGenState generates a random GenesisState for the inflation module. The issuer genesis state must already
+// have been generated, as every issued denomination is given a random inflation.
+func RandomizedGenState(simState *module.SimulationState) {
+	var issuerGenesis issuertypes.GenesisState
+	if bz, ok := simState.GenState[issuertypes.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, &issuerGenesis)
+	}
+
+	assets := make(types.InflationAssets, 0)
+	for _, issuer := range issuerGenesis.Issuers {
+		for _, denom := range issuer.Denoms {
+			assets = append(assets, types.InflationAsset{
+				Denom:     denom,
+				Inflation: GenInflation(simState.Rand),
+				Accum:     sdk.ZeroDec(),
+			})
+		}
+	}
+
+	inflationGenesis := types.GenesisState{
+		InflationState: types.InflationState{
+			LastAppliedTime:   simState.GenTimestamp.UTC(),
+			LastAppliedHeight: sdk.ZeroInt(),
+			InflationAssets:   assets,
+		},
+	}
+
+	bz, err := json.MarshalIndent(inflationGenesis.InflationState.InflationAssets, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated inflation assets:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&inflationGenesis)
+}
@@ -19,10 +19,6 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 )
 
-const (
-	keyIssuerList = "issuers"
-)
-
 type Keeper struct {
 	cdc      codec.BinaryCodec
 	storeKey sdk.StoreKey
@@ -155,7 +151,7 @@ func (k Keeper) logger(ctx sdk.Context) log.Logger {
 
 func (k Keeper) GetIssuers(ctx sdk.Context) []types.Issuer {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte(keyIssuerList))
+	bz := store.Get([]byte(types.KeyIssuerList))
 	if bz == nil {
 		return nil
 	}
@@ -168,7 +164,7 @@ func (k Keeper) GetIssuers(ctx sdk.Context) []types.Issuer {
 func (k Keeper) setIssuers(ctx sdk.Context, issuers []types.Issuer) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshalLengthPrefixed(&types.Issuers{Issuers: issuers})
-	store.Set([]byte(keyIssuerList), bz)
+	store.Set([]byte(types.KeyIssuerList), bz)
 }
 
 func (k Keeper) AddIssuer(ctx sdk.Context, newIssuer types.Issuer, denomMetadata []authtypes.Denomination) (*sdk.Result, error) {
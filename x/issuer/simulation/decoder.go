@@ -0,0 +1,25 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/e-money/em-ledger/x/issuer/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's Value to the list of issuers.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, []byte(types.KeyIssuerList)):
+			var issuersA, issuersB types.Issuers
+			cdc.MustUnmarshalLengthPrefixed(kvA.Value, &issuersA)
+			cdc.MustUnmarshalLengthPrefixed(kvB.Value, &issuersB)
+			return fmt.Sprintf("%v\n%v", issuersA, issuersB)
+		default:
+			panic(fmt.Sprintf("invalid issuer key %X", kvA.Key))
+		}
+	}
+}
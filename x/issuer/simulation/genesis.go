@@ -0,0 +1,55 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/e-money/em-ledger/x/issuer/types"
+)
+
+// denominations are the stablecoin denominations that are assigned to the issuers of the simulation.
+var denominations = []string{"echf", "edkk", "eeur", "enok", "esek"}
+
+// RandomIssuers distributes the simulated denominations among up to three random accounts.
+func RandomIssuers(r *rand.Rand, accs []simtypes.Account) []types.Issuer {
+	n := 1 + r.Intn(3)
+	if n > len(accs) {
+		n = len(accs)
+	}
+
+	issuers := make([]types.Issuer, n)
+	for i, idx := range r.Perm(len(accs))[:n] {
+		issuers[i].Address = accs[idx].Address.String()
+	}
+
+	for _, denom := range denominations {
+		i := r.Intn(n)
+		issuers[i].Denoms = append(issuers[i].Denoms, denom)
+	}
+
+	res := make([]types.Issuer, 0, n)
+	for _, issuer := range issuers {
+		if len(issuer.Denoms) == 0 {
+			continue
+		}
+
+		// The keeper looks up denominations using binary search.
+		sort.Strings(issuer.Denoms)
+		res = append(res, issuer)
+	}
+
+	return res
+}
+
+// RandomizedGenState generates a random GenesisState for the issuer module.
+func RandomizedGenState(simState *module.SimulationState) {
+	issuerGenesis := types.GenesisState{
+		Issuers: RandomIssuers(simState.Rand, simState.Accounts),
+	}
+
+	fmt.Printf("Selected randomly generated issuers:\n%s\n", types.Issuers{Issuers: issuerGenesis.Issuers})
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&issuerGenesis)
+}
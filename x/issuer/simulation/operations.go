@@ -0,0 +1,228 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/e-money/em-ledger/util"
+	"github.com/e-money/em-ledger/x/issuer/keeper"
+	"github.com/e-money/em-ledger/x/issuer/types"
+	lptypes "github.com/e-money/em-ledger/x/liquidityprovider/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgIncreaseMintable        = "op_weight_msg_increase_mintable"
+	OpWeightMsgDecreaseMintable        = "op_weight_msg_decrease_mintable"
+	OpWeightMsgRevokeLiquidityProvider = "op_weight_msg_revoke_liquidity_provider"
+	OpWeightMsgSetInflation            = "op_weight_msg_set_inflation"
+
+	DefaultWeightMsgIncreaseMintable        = 20
+	DefaultWeightMsgDecreaseMintable        = 10
+	DefaultWeightMsgRevokeLiquidityProvider = 2
+	DefaultWeightMsgSetInflation            = 5
+)
+
+var (
+	TypeMsgIncreaseMintable        = types.MsgIncreaseMintable{}.Type()
+	TypeMsgDecreaseMintable        = types.MsgDecreaseMintable{}.Type()
+	TypeMsgRevokeLiquidityProvider = types.MsgRevokeLiquidityProvider{}.Type()
+	TypeMsgSetInflation            = types.MsgSetInflation{}.Type()
+)
+
+// WeightedOperations returns all the issuer module operations with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper,
+	k keeper.Keeper, lpk types.LiquidityProviderKeeper,
+) simulation.WeightedOperations {
+	weight := func(key string, defaultWeight int) int {
+		var w int
+		appParams.GetOrGenerate(cdc, key, &w, nil, func(_ *rand.Rand) { w = defaultWeight })
+		return w
+	}
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgIncreaseMintable, DefaultWeightMsgIncreaseMintable),
+			SimulateMsgIncreaseMintable(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgDecreaseMintable, DefaultWeightMsgDecreaseMintable),
+			SimulateMsgDecreaseMintable(ak, bk, k, lpk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgRevokeLiquidityProvider, DefaultWeightMsgRevokeLiquidityProvider),
+			SimulateMsgRevokeLiquidityProvider(ak, bk, k, lpk),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgSetInflation, DefaultWeightMsgSetInflation),
+			SimulateMsgSetInflation(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgIncreaseMintable generates a MsgIncreaseMintable, which grants a random account a mintable amount of
+// the denominations of a random issuer.
+func SimulateMsgIncreaseMintable(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, issuerAccount, ok := randomIssuer(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgIncreaseMintable, "no issuer"), nil, nil
+		}
+
+		lpAccount, _ := simtypes.RandomAcc(r, accs)
+
+		mintable := sdk.NewCoins()
+		for _, denom := range issuer.Denoms {
+			if r.Intn(2) == 0 {
+				mintable = mintable.Add(sdk.NewCoin(denom, sdk.NewInt(1+r.Int63n(1e12))))
+			}
+		}
+		if mintable.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgIncreaseMintable, "no mintable amount"), nil, nil
+		}
+
+		msg := &types.MsgIncreaseMintable{
+			Issuer:            issuer.Address,
+			LiquidityProvider: lpAccount.Address.String(),
+			MintableIncrease:  mintable,
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, issuerAccount, msg, nil)
+	}
+}
+
+// SimulateMsgDecreaseMintable generates a MsgDecreaseMintable, which reduces the mintable amount of a liquidity
+// provider of a random issuer.
+func SimulateMsgDecreaseMintable(
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, lpk types.LiquidityProviderKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, issuerAccount, ok := randomIssuer(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgDecreaseMintable, "no issuer"), nil, nil
+		}
+
+		lp, ok := randomLiquidityProvider(r, ctx, lpk, issuer)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgDecreaseMintable, "no liquidity provider"), nil, nil
+		}
+
+		decrease := sdk.NewCoins()
+		for _, denom := range issuer.Denoms {
+			if amount := lp.Mintable.AmountOf(denom); amount.IsPositive() {
+				decrease = decrease.Add(sdk.NewCoin(denom, simtypes.RandomAmount(r, amount)))
+			}
+		}
+		if decrease.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgDecreaseMintable, "no mintable amount"), nil, nil
+		}
+
+		msg := &types.MsgDecreaseMintable{
+			Issuer:            issuer.Address,
+			LiquidityProvider: lp.Address,
+			MintableDecrease:  decrease,
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, issuerAccount, msg, nil)
+	}
+}
+
+// SimulateMsgRevokeLiquidityProvider generates a MsgRevokeLiquidityProvider for a liquidity provider of a random
+// issuer.
+func SimulateMsgRevokeLiquidityProvider(
+	ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper, lpk types.LiquidityProviderKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, issuerAccount, ok := randomIssuer(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgRevokeLiquidityProvider, "no issuer"), nil, nil
+		}
+
+		lp, ok := randomLiquidityProvider(r, ctx, lpk, issuer)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgRevokeLiquidityProvider, "no liquidity provider"), nil, nil
+		}
+
+		msg := &types.MsgRevokeLiquidityProvider{
+			Issuer:            issuer.Address,
+			LiquidityProvider: lp.Address,
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, issuerAccount, msg, nil)
+	}
+}
+
+// SimulateMsgSetInflation generates a MsgSetInflation with an annual inflation of at most 10%.
+func SimulateMsgSetInflation(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, issuerAccount, ok := randomIssuer(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgSetInflation, "no issuer"), nil, nil
+		}
+
+		msg := &types.MsgSetInflation{
+			Issuer:        issuer.Address,
+			Denom:         issuer.Denoms[r.Intn(len(issuer.Denoms))],
+			InflationRate: sdk.NewDecWithPrec(int64(r.Intn(101)), 3),
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, issuerAccount, msg, nil)
+	}
+}
+
+// randomIssuer returns a random issuer with at least one denomination and its simulation account.
+func randomIssuer(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+) (types.Issuer, simtypes.Account, bool) {
+	issuers := make([]types.Issuer, 0)
+	for _, issuer := range k.GetIssuers(ctx) {
+		if len(issuer.Denoms) > 0 {
+			issuers = append(issuers, issuer)
+		}
+	}
+	if len(issuers) == 0 {
+		return types.Issuer{}, simtypes.Account{}, false
+	}
+
+	issuer := issuers[r.Intn(len(issuers))]
+	address, err := sdk.AccAddressFromBech32(issuer.Address)
+	if err != nil {
+		return types.Issuer{}, simtypes.Account{}, false
+	}
+
+	account, found := simtypes.FindAccount(accs, address)
+	return issuer, account, found
+}
+
+// randomLiquidityProvider returns a random liquidity provider that may mint a denomination of issuer.
+func randomLiquidityProvider(
+	r *rand.Rand, ctx sdk.Context, lpk types.LiquidityProviderKeeper, issuer types.Issuer,
+) (lptypes.LiquidityProviderAccount, bool) {
+	candidates := make([]lptypes.LiquidityProviderAccount, 0)
+	for _, lp := range lpk.GetAllLiquidityProviderAccounts(ctx) {
+		for _, denom := range issuer.Denoms {
+			if lp.Mintable.AmountOf(denom).IsPositive() {
+				candidates = append(candidates, lp)
+				break
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return lptypes.LiquidityProviderAccount{}, false
+	}
+
+	return candidates[r.Intn(len(candidates))], true
+}
@@ -2,7 +2,9 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	lptypes "github.com/e-money/em-ledger/x/liquidityprovider/types"
 )
 
 type (
@@ -14,5 +16,15 @@ type (
 	BankKeeper interface {
 		GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
 		SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+		SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+		SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	}
+
+	AccountKeeper interface {
+		GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	}
+
+	LiquidityProviderKeeper interface {
+		GetAllLiquidityProviderAccounts(ctx sdk.Context) []lptypes.LiquidityProviderAccount
 	}
 )
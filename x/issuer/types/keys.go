@@ -9,4 +9,7 @@ const (
 	QuerierRoute = ModuleName
 
 	QueryIssuers = "issuers"
+
+	// KeyIssuerList is the store key of the list of issuers.
+	KeyIssuerList = "issuers"
 )
@@ -0,0 +1,26 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/e-money/em-ledger/x/liquidityprovider/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's Value to the corresponding
+// liquidity provider account.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.ProviderKeyPrefix):
+			var lpA, lpB types.LiquidityProviderAccount
+			cdc.MustUnmarshalLengthPrefixed(kvA.Value, &lpA)
+			cdc.MustUnmarshalLengthPrefixed(kvB.Value, &lpB)
+			return fmt.Sprintf("%v\n%v", lpA, lpB)
+		default:
+			panic(fmt.Sprintf("invalid liquidityprovider key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	issuertypes "github.com/e-money/em-ledger/x/issuer/types"
+	"github.com/e-money/em-ledger/x/liquidityprovider/types"
+)
+
+// RandomGenesisAccounts makes a random subset of the accounts liquidity providers of the denominations of the issuers.
+func RandomGenesisAccounts(r *rand.Rand, accs []simtypes.Account, issuers []issuertypes.Issuer) []types.GenesisAcc {
+	denoms := make([]string, 0)
+	for _, issuer := range issuers {
+		denoms = append(denoms, issuer.Denoms...)
+	}
+	if len(denoms) == 0 {
+		return nil
+	}
+
+	genAccs := make([]types.GenesisAcc, 0)
+	for _, acc := range accs {
+		if r.Intn(4) != 0 {
+			continue
+		}
+
+		mintable := sdk.NewCoins()
+		for _, denom := range denoms {
+			if r.Intn(2) == 0 {
+				mintable = mintable.Add(sdk.NewCoin(denom, sdk.NewInt(1+r.Int63n(1e12))))
+			}
+		}
+		if mintable.Empty() {
+			continue
+		}
+
+		genAccs = append(genAccs, types.GenesisAcc{
+			Address:  acc.Address.String(),
+			Mintable: mintable,
+		})
+	}
+
+	return genAccs
+}
+
+// RandomizedGenState generates a random GenesisState for the liquidityprovider module. The issuer genesis state must
+// already have been generated, as the liquidity providers are granted mintable amounts of the issued denominations.
+func RandomizedGenState(simState *module.SimulationState) {
+	var issuerGenesis issuertypes.GenesisState
+	if bz, ok := simState.GenState[issuertypes.ModuleName]; ok {
+		simState.Cdc.MustUnmarshalJSON(bz, &issuerGenesis)
+	}
+
+	lpGenesis := types.GenesisState{
+		Accounts: RandomGenesisAccounts(simState.Rand, simState.Accounts, issuerGenesis.Issuers),
+	}
+
+	bz, err := json.MarshalIndent(lpGenesis.Accounts, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated liquidity providers:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&lpGenesis)
+}
@@ -0,0 +1,129 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/e-money/em-ledger/util"
+	"github.com/e-money/em-ledger/x/liquidityprovider/keeper"
+	"github.com/e-money/em-ledger/x/liquidityprovider/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgMintTokens = "op_weight_msg_mint_tokens"
+	OpWeightMsgBurnTokens = "op_weight_msg_burn_tokens"
+
+	DefaultWeightMsgMintTokens = 50
+	DefaultWeightMsgBurnTokens = 20
+)
+
+var (
+	TypeMsgMintTokens = types.MsgMintTokens{}.Type()
+	TypeMsgBurnTokens = types.MsgBurnTokens{}.Type()
+)
+
+// WeightedOperations returns all the liquidityprovider module operations with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgMintTokens, weightMsgBurnTokens int
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMintTokens, &weightMsgMintTokens, nil,
+		func(_ *rand.Rand) { weightMsgMintTokens = DefaultWeightMsgMintTokens },
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurnTokens, &weightMsgBurnTokens, nil,
+		func(_ *rand.Rand) { weightMsgBurnTokens = DefaultWeightMsgBurnTokens },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgMintTokens, SimulateMsgMintTokens(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgBurnTokens, SimulateMsgBurnTokens(ak, bk, k)),
+	}
+}
+
+// SimulateMsgMintTokens generates a MsgMintTokens of at most the mintable amount of a random liquidity provider.
+func SimulateMsgMintTokens(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		lp, simAccount, ok := randomLiquidityProvider(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgMintTokens, "no liquidity provider"), nil, nil
+		}
+
+		amount := randomSubset(r, lp.Mintable)
+		if amount.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgMintTokens, "no mintable amount"), nil, nil
+		}
+
+		msg := &types.MsgMintTokens{
+			LiquidityProvider: lp.Address,
+			Amount:            amount,
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgBurnTokens generates a MsgBurnTokens of at most the spendable balance of a random liquidity provider.
+func SimulateMsgBurnTokens(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		lp, simAccount, ok := randomLiquidityProvider(r, ctx, k, accs)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurnTokens, "no liquidity provider"), nil, nil
+		}
+
+		amount := randomSubset(r, bk.SpendableCoins(ctx, simAccount.Address))
+		if amount.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgBurnTokens, "no balance"), nil, nil
+		}
+
+		msg := &types.MsgBurnTokens{
+			LiquidityProvider: lp.Address,
+			Amount:            amount,
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, simAccount, msg, amount)
+	}
+}
+
+// randomLiquidityProvider returns a random liquidity provider and its simulation account.
+func randomLiquidityProvider(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+) (types.LiquidityProviderAccount, simtypes.Account, bool) {
+	lps := k.GetAllLiquidityProviderAccounts(ctx)
+	if len(lps) == 0 {
+		return types.LiquidityProviderAccount{}, simtypes.Account{}, false
+	}
+
+	lp := lps[r.Intn(len(lps))]
+	address, err := sdk.AccAddressFromBech32(lp.Address)
+	if err != nil {
+		return types.LiquidityProviderAccount{}, simtypes.Account{}, false
+	}
+
+	simAccount, found := simtypes.FindAccount(accs, address)
+	return lp, simAccount, found
+}
+
+// randomSubset returns random amounts of a random selection of the coins.
+func randomSubset(r *rand.Rand, coins sdk.Coins) sdk.Coins {
+	res := sdk.NewCoins()
+	for _, coin := range coins {
+		if r.Intn(2) == 0 || !coin.Amount.IsPositive() {
+			continue
+		}
+
+		res = res.Add(sdk.NewCoin(coin.Denom, simtypes.RandomAmount(r, coin.Amount)))
+	}
+
+	return res
+}
@@ -0,0 +1,21 @@
+package market
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func BeginBlocker(ctx sdk.Context, k *Keeper) {
+	// Remove orders that expired between blocks before any new orders are matched against them.
+	k.ExpireTimedOrders(ctx)
+}
+
+func EndBlocker(ctx sdk.Context, k *Keeper) {
+	// Conditional orders beyond the number placed by a single transaction are placed before orders expire.
+	k.TriggerPendingConditionalOrders(ctx)
+
+	// Orders are good through their expiry block, so they are removed once it has been processed.
+	k.ExpireBlockOrders(ctx)
+
+	// Balance changes that exceeded the work of a single transfer are completed before the block is committed.
+	k.ReevaluatePendingBalanceChanges(ctx)
+}
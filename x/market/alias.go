@@ -57,6 +57,10 @@ type (
 	MsgAddLimitOrder           = types.MsgAddLimitOrder
 	MsgCancelOrder             = types.MsgCancelOrder
 	MsgCancelReplaceLimitOrder = types.MsgCancelReplaceLimitOrder
+	MsgBatchOrders             = types.MsgBatchOrders
+	MsgCancelAllOrders         = types.MsgCancelAllOrders
+	MsgAddConditionalOrder     = types.MsgAddConditionalOrder
+	MsgAddSourceMarketOrder    = types.MsgAddSourceMarketOrder
 
 	AccountKeeper = types.AccountKeeper
 	BankKeeper    = types.BankKeeper
@@ -1,6 +1,10 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,6 +13,17 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	flag_Levels      = "levels"
+	flag_Interval    = "interval"
+	flag_Limit       = "limit"
+	flag_Side        = "side"
+	flag_Source      = "source"
+	flag_Destination = "destination"
+	flag_CreatedFrom = "created-from"
+	flag_CreatedTo   = "created-to"
+)
+
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -22,6 +37,15 @@ func GetQueryCmd() *cobra.Command {
 		GetInstrumentsCmd(),
 		GetInstrumentCmd(),
 		GetByAccountCmd(),
+		GetConditionalOrdersCmd(),
+		GetOrderCmd(),
+		GetOrderByClientIdCmd(),
+		GetOrderHistoryCmd(),
+		GetQuoteCmd(),
+		GetDepthCmd(),
+		GetTradesCmd(),
+		GetCandlesCmd(),
+		GetParamsCmd(),
 	)
 
 	return cmd
@@ -31,6 +55,118 @@ func GetByAccountCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "account [key_or_address]",
 		Short: "Query orders placed by a specific account",
+		Long: `Query orders placed by a specific account, sorted by client order id.
+The orders can be limited to an instrument and to a range of creation times.
+
+Example:
+ emd query market account emoney1... --source eeur --destination echf --created-from 2021-06-01T00:00:00Z
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				// Named key specified
+				addr = clientCtx.FromAddress
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			source, err := cmd.Flags().GetString(flag_Source)
+			if err != nil {
+				return err
+			}
+
+			destination, err := cmd.Flags().GetString(flag_Destination)
+			if err != nil {
+				return err
+			}
+
+			createdFrom, err := getTimeFlag(cmd, flag_CreatedFrom)
+			if err != nil {
+				return err
+			}
+
+			createdTo, err := getTimeFlag(cmd, flag_CreatedTo)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ByAccount(cmd.Context(), &types.QueryByAccountRequest{
+				Address:     addr.String(),
+				Pagination:  pageReq,
+				Source:      source,
+				Destination: destination,
+				CreatedFrom: createdFrom,
+				CreatedTo:   createdTo,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONCodec(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "orders")
+	cmd.Flags().String(flag_Source, "", "Only return orders selling this denomination")
+	cmd.Flags().String(flag_Destination, "", "Only return orders buying this denomination")
+	cmd.Flags().String(flag_CreatedFrom, "", "Only return orders created at or after this time in RFC3339 format")
+	cmd.Flags().String(flag_CreatedTo, "", "Only return orders created before this time in RFC3339 format")
+	return cmd
+}
+
+func GetConditionalOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conditional-orders [key_or_address]",
+		Short: "Query the pending conditional orders of a specific account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				// Named key specified
+				addr = clientCtx.FromAddress
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ConditionalOrders(cmd.Context(), &types.QueryConditionalOrdersRequest{
+				Address:    addr.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONCodec(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "conditional orders")
+	return cmd
+}
+
+func GetOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order [order-id]",
+		Short: "Query an active order by its order ID",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -38,13 +174,46 @@ func GetByAccountCmd() *cobra.Command {
 				return err
 			}
 
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id %v: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Order(cmd.Context(), &types.QueryOrderByIdRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONCodec(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetOrderByClientIdCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-by-client-id [key_or_address] [client-order-id]",
+		Short: "Query an active order of a specific account by its client order ID",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
 			addr, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				// Named key specified
 				addr = clientCtx.FromAddress
 			}
+
 			queryClient := types.NewQueryClient(clientCtx)
-			res, err := queryClient.ByAccount(cmd.Context(), &types.QueryByAccountRequest{Address: addr.String()})
+			res, err := queryClient.OrderByClientId(cmd.Context(), &types.QueryOrderByClientIdRequest{
+				Address:       addr.String(),
+				ClientOrderId: args[1],
+			})
 			if err != nil {
 				return err
 			}
@@ -56,21 +225,197 @@ func GetByAccountCmd() *cobra.Command {
 	return cmd
 }
 
+func GetOrderHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-history [key_or_address]",
+		Short: "Query the completed orders of a specific account",
+		Long: `Query the filled, canceled and expired orders of a specific account, oldest first.
+
+Example:
+ emd query market order-history mykey --limit 20 --reverse
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				// Named key specified
+				addr = clientCtx.FromAddress
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OrderHistory(cmd.Context(), &types.QueryOrderHistoryRequest{
+				Address:    addr.String(),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONCodec(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "order history")
+	return cmd
+}
+
+func GetQuoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote [key_or_address] [source-amount] [destination-amount]",
+		Short: "Query how a limit order would be executed against the current book",
+		Long: `Execute a limit order for a specific account without placing it and report the expected fills, the average price
+and the instruments that would be traded.
+
+Example:
+ emd query market quote mykey 200eur 240usd --time-in-force IOC
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				// Named key specified
+				addr = clientCtx.FromAddress
+			}
+
+			src, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			dst, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			tif, err := cmd.Flags().GetString(flag_TimeInForce)
+			if err != nil {
+				return err
+			}
+
+			timeInForce, err := types.TimeInForceFromString(tif)
+			if err != nil {
+				return err
+			}
+
+			selfTradePrevention, err := getSelfTradePreventionFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Quote(cmd.Context(), &types.QueryQuoteRequest{
+				Owner:               addr.String(),
+				Source:              src,
+				Destination:         dst,
+				TimeInForce:         timeInForce,
+				SelfTradePrevention: selfTradePrevention,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONCodec(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flag_TimeInForce, "GTC", "Select the order's time-in-force value (GTC|IOC|FOK)")
+	addSelfTradePreventionFlag(cmd)
+	return cmd
+}
+
 func GetInstrumentCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "instrument [source-denomination] [destination-denomination]",
 		Short: "Query the order book of a specific instrument",
-		Args:  cobra.ExactArgs(2),
+		Long: `Query the order book of a specific instrument, best price first.
+Asks are orders selling the source denomination, bids are orders selling the destination denomination.
+
+Example:
+ emd query market instrument eeur echf --side both --limit 10
+`,
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 
+			limit, err := cmd.Flags().GetUint32(flag_Limit)
+			if err != nil {
+				return err
+			}
+
+			sideFlag, err := cmd.Flags().GetString(flag_Side)
+			if err != nil {
+				return err
+			}
+			side, err := types.OrderSideFromString(sideFlag)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.Instrument(cmd.Context(), &types.QueryInstrumentRequest{
 				Source:      args[0],
 				Destination: args[1],
+				Limit:       limit,
+				Side:        side,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONCodec(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint32(flag_Limit, 0, "Maximum number of orders per side, or 0 for all orders")
+	cmd.Flags().String(flag_Side, "asks", "Side of the order book to return (asks|bids|both)")
+	return cmd
+}
+
+func GetDepthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "depth [source-denomination] [destination-denomination]",
+		Short: "Query the order book of an instrument aggregated by price level",
+		Long: `Query the order book of an instrument aggregated by price level.
+Asks are orders selling the source denomination, bids are orders selling the destination denomination.
+
+Example:
+ emd query market depth eeur echf --levels 10
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			levels, err := cmd.Flags().GetUint32(flag_Levels)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Depth(cmd.Context(), &types.QueryDepthRequest{
+				Source:      args[0],
+				Destination: args[1],
+				Levels:      levels,
 			})
 			if err != nil {
 				return err
@@ -80,6 +425,116 @@ func GetInstrumentCmd() *cobra.Command {
 		},
 	}
 	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint32(flag_Levels, types.DefaultDepthLevels, "Maximum number of price levels per side")
+	return cmd
+}
+
+func GetTradesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades [source-denomination] [destination-denomination]",
+		Short: "Query the recent trades of an instrument",
+		Long: `Query the recent trades of an instrument, stated in the direction from source to destination.
+
+Example:
+ emd query market trades eeur echf --limit 50 --reverse
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Trades(cmd.Context(), &types.QueryTradesRequest{
+				Source:      args[0],
+				Destination: args[1],
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONCodec(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trades")
+	return cmd
+}
+
+func GetCandlesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [source-denomination] [destination-denomination]",
+		Short: "Query the OHLCV candles of an instrument",
+		Long: `Query the open, high, low, close and volume candles of an instrument for one of the configured intervals.
+
+Example:
+ emd query market candles eeur echf --interval 1h --limit 24 --reverse
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetDuration(flag_Interval)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Candles(cmd.Context(), &types.QueryCandlesRequest{
+				Source:      args[0],
+				Destination: args[1],
+				Interval:    interval,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.WithJSONCodec(apptypes.NewMarshaller(clientCtx)).PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "candles")
+	cmd.Flags().Duration(flag_Interval, time.Hour, "Candle interval, e.g. 1m, 1h or 24h")
+	return cmd
+}
+
+func GetParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the market module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
@@ -106,3 +561,17 @@ func GetInstrumentsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func getTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %v value: %w", flag, err)
+	}
+
+	return &t, nil
+}
@@ -1,6 +1,10 @@
 package cli
 
 import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -10,9 +14,19 @@ import (
 )
 
 const (
-	flag_TimeInForce = "time-in-force"
-
-	flag_TimeInForceDescription = "Select the order's time-in-force value (GTC|IOC|FOK)"
+	flag_TimeInForce   = "time-in-force"
+	flag_GoodTillTime  = "good-till-time"
+	flag_GoodTillBlock = "good-till-block"
+	flag_PostOnly      = "post-only"
+	flag_SelfTrade     = "self-trade-prevention"
+	flag_DisplaySize   = "display-size"
+
+	flag_TimeInForceDescription   = "Select the order's time-in-force value (GTC|IOC|FOK|GTT|GTB)"
+	flag_GoodTillTimeDescription  = "Expiry time of a GTT order in RFC3339 format, e.g. 2021-06-01T12:00:00Z"
+	flag_GoodTillBlockDescription = "Last block height in which a GTB order can be matched"
+	flag_PostOnlyDescription      = "Only add liquidity: reject the order (reject) or re-price it (reprice) if it would match immediately"
+	flag_SelfTradeDescription     = "Prevent matching the owner's own orders by canceling the new order, the resting orders or both (cancel-newest|cancel-oldest|cancel-both)"
+	flag_DisplaySizeDescription   = "Place an iceberg order that only displays this source amount in the book at a time"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -28,8 +42,12 @@ func GetTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		AddLimitOrderCmd(),
 		AddMarketOrderCmd(),
+		AddSourceMarketOrderCmd(),
 		CancelOrderCmd(),
 		CancelReplaceOrder(),
+		BatchOrdersCmd(),
+		CancelAllOrdersCmd(),
+		AddConditionalOrderCmd(),
 	)
 	return txCmd
 }
@@ -66,12 +84,38 @@ func AddLimitOrderCmd() *cobra.Command {
 				return err
 			}
 
+			goodTillTime, goodTillBlock, err := getExpiryFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			postOnly, err := getPostOnlyFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			selfTradePrevention, err := getSelfTradePreventionFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			displaySize, err := getDisplaySizeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgAddLimitOrder{
 				Owner:         clientCtx.GetFromAddress().String(),
 				TimeInForce:   timeInForce,
 				Source:        src,
 				Destination:   dst,
 				ClientOrderId: clientOrderID,
+				GoodTillTime:  goodTillTime,
+				GoodTillBlock: goodTillBlock,
+				PostOnly:      postOnly,
+				DisplaySize:   displaySize,
+
+				SelfTradePrevention: selfTradePrevention,
 			}
 
 			err = msg.ValidateBasic()
@@ -83,7 +127,93 @@ func AddLimitOrderCmd() *cobra.Command {
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().String(flag_TimeInForce, "GTC", flag_TimeInForceDescription)
+	addTimeInForceFlags(cmd)
+	addPostOnlyFlag(cmd)
+	addSelfTradePreventionFlag(cmd)
+	cmd.Flags().String(flag_DisplaySize, "", flag_DisplaySizeDescription)
+	return cmd
+}
+
+func AddConditionalOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-conditional [stop-loss|take-profit] [trigger-price] [source-amount] [destination-amount] [client-orderid]",
+		Short: "Create a limit order that is sent to the market once the last traded price meets the trigger price",
+		Long: `Create a limit order that is sent to the market once the last traded price meets the trigger price.
+The last traded price of the source/destination instrument is stated as destination per source.
+A stop-loss order is triggered by a price at or below the trigger price, and a take-profit order by a price at or above it.`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			condition, err := types.ConditionTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			src, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return
+			}
+
+			dst, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return
+			}
+
+			clientOrderID := args[4]
+
+			tif, err := cmd.Flags().GetString(flag_TimeInForce)
+			if err != nil {
+				return err
+			}
+			timeInForce, err := types.TimeInForceFromString(tif)
+			if err != nil {
+				return err
+			}
+
+			goodTillTime, goodTillBlock, err := getExpiryFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			selfTradePrevention, err := getSelfTradePreventionFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddConditionalOrder{
+				Owner:         clientCtx.GetFromAddress().String(),
+				TimeInForce:   timeInForce,
+				Source:        src,
+				Destination:   dst,
+				ClientOrderId: clientOrderID,
+				GoodTillTime:  goodTillTime,
+				GoodTillBlock: goodTillBlock,
+				Condition:     condition,
+				TriggerPrice:  triggerPrice,
+
+				SelfTradePrevention: selfTradePrevention,
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addTimeInForceFlags(cmd)
+	addSelfTradePreventionFlag(cmd)
 	return cmd
 }
 
@@ -126,6 +256,11 @@ Example:
 				return err
 			}
 
+			selfTradePrevention, err := getSelfTradePreventionFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgAddMarketOrder{
 				Owner:         clientCtx.GetFromAddress().String(),
 				TimeInForce:   timeInForce,
@@ -133,6 +268,8 @@ Example:
 				Destination:   dst,
 				ClientOrderId: clientOrderID,
 				MaxSlippage:   slippage,
+
+				SelfTradePrevention: selfTradePrevention,
 			}
 
 			err = msg.ValidateBasic()
@@ -145,6 +282,78 @@ Example:
 	}
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(flag_TimeInForce, "GTC", flag_TimeInForceDescription)
+	addSelfTradePreventionFlag(cmd)
+	return cmd
+}
+
+func AddSourceMarketOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-source-market [source-amount] [destination-denom] [market-slippage] [client-orderid]",
+		Short: "Create a market order that sells the entire source amount",
+		Long: `Create an order that sells the source amount for as much of the destination as possible. The order is priced from
+the best price in the book, which it may not fall below by more than the slippage. The remainder of the order is never
+placed in the book.
+
+Example:
+ emd tx market add-source-market 100eeur echf 0.05 order12345
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			src, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return
+			}
+
+			dstDenom := args[1]
+
+			slippage, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientOrderID := args[3]
+
+			tif, err := cmd.Flags().GetString(flag_TimeInForce)
+			if err != nil {
+				return err
+			}
+			timeInForce, err := types.TimeInForceFromString(tif)
+			if err != nil {
+				return err
+			}
+
+			selfTradePrevention, err := getSelfTradePreventionFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddSourceMarketOrder{
+				Owner:         clientCtx.GetFromAddress().String(),
+				TimeInForce:   timeInForce,
+				Source:        src,
+				Destination:   dstDenom,
+				ClientOrderId: clientOrderID,
+				MaxSlippage:   slippage,
+
+				SelfTradePrevention: selfTradePrevention,
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flag_TimeInForce, "IOC", "Select the order's time-in-force value (IOC|FOK)")
+	addSelfTradePreventionFlag(cmd)
 	return cmd
 }
 
@@ -179,6 +388,49 @@ func CancelOrderCmd() *cobra.Command {
 	return cmd
 }
 
+func CancelAllOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-all [[source-denom] [destination-denom]]",
+		Short: "Cancel all orders of the account, optionally only those of a single instrument",
+		Long: fmt.Sprintf(`Cancel all orders of the account, optionally only those of a single instrument.
+At most %v orders are canceled per transaction.
+
+Example:
+ emd tx market cancel-all --from mykey
+ emd tx market cancel-all eeur echf --from mykey
+`, types.MaxCancelAllOrders),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 && len(args) != 2 {
+				return fmt.Errorf("accepts 0 or 2 arg(s), received %d", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelAllOrders{
+				Owner: clientCtx.GetFromAddress().String(),
+			}
+			if len(args) == 2 {
+				msg.Source, msg.Destination = args[0], args[1]
+			}
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CancelReplaceOrder() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "cancelreplace [original-client-order-id] [source-amount] [destination-amount] [client-orderid]",
@@ -213,6 +465,26 @@ func CancelReplaceOrder() *cobra.Command {
 				return err
 			}
 
+			goodTillTime, goodTillBlock, err := getExpiryFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			postOnly, err := getPostOnlyFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			selfTradePrevention, err := getSelfTradePreventionFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			displaySize, err := getDisplaySizeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgCancelReplaceLimitOrder{
 				Owner:             clientCtx.GetFromAddress().String(),
 				TimeInForce:       timeInForce,
@@ -220,6 +492,12 @@ func CancelReplaceOrder() *cobra.Command {
 				Destination:       dst,
 				OrigClientOrderId: origClientOrderID,
 				NewClientOrderId:  newClientOrderID,
+				GoodTillTime:      goodTillTime,
+				GoodTillBlock:     goodTillBlock,
+				PostOnly:          postOnly,
+				DisplaySize:       displaySize,
+
+				SelfTradePrevention: selfTradePrevention,
 			}
 
 			err = msg.ValidateBasic()
@@ -231,7 +509,146 @@ func CancelReplaceOrder() *cobra.Command {
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
-	cmd.Flags().String(flag_TimeInForce, "GTC", flag_TimeInForceDescription)
+	addTimeInForceFlags(cmd)
+	addPostOnlyFlag(cmd)
+	addSelfTradePreventionFlag(cmd)
+	cmd.Flags().String(flag_DisplaySize, "", flag_DisplaySizeDescription)
+
+	return cmd
+}
+
+func BatchOrdersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch [batch-file]",
+		Short: "Cancel, replace and add orders atomically",
+		Long: `Cancel, replace and add orders atomically using a batch read from a JSON file.
+Cancels are executed first, then cancel-replaces and finally new limit orders. If any of them fails, none are executed.
+The owner of the batch and its items is the --from account.
+
+Example:
+ emd tx market batch batch.json --from mykey
+
+Where batch.json contains:
+{
+  "cancels": [ { "client_order_id": "order1" } ],
+  "cancel_replaces": [
+    {
+      "original_client_order_id": "order2",
+      "new_client_order_id": "order3",
+      "time_in_force": "TIME_IN_FORCE_GOOD_TILL_CANCEL",
+      "source": { "denom": "eeur", "amount": "1000" },
+      "destination": { "denom": "echf", "amount": "1100" }
+    }
+  ],
+  "limit_orders": [
+    {
+      "client_order_id": "order4",
+      "time_in_force": "TIME_IN_FORCE_GOOD_TILL_CANCEL",
+      "source": { "denom": "echf", "amount": "500" },
+      "destination": { "denom": "eeur", "amount": "460" }
+    }
+  ]
+}
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBatchOrders{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+				return fmt.Errorf("invalid batch file: %w", err)
+			}
+			msg.Owner = clientCtx.GetFromAddress().String()
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// addTimeInForceFlags adds the time-in-force flag along with the expiry flags used by GTT and GTB orders.
+func addTimeInForceFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flag_TimeInForce, "GTC", flag_TimeInForceDescription)
+	cmd.Flags().String(flag_GoodTillTime, "", flag_GoodTillTimeDescription)
+	cmd.Flags().Int64(flag_GoodTillBlock, 0, flag_GoodTillBlockDescription)
+}
+
+// addPostOnlyFlag adds the post-only flag, which rejects orders that would match immediately when given without a value.
+func addPostOnlyFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flag_PostOnly, "", flag_PostOnlyDescription)
+	cmd.Flags().Lookup(flag_PostOnly).NoOptDefVal = "reject"
+}
+
+func getPostOnlyFlag(cmd *cobra.Command) (types.PostOnlyMode, error) {
+	postOnly, err := cmd.Flags().GetString(flag_PostOnly)
+	if err != nil {
+		return 0, err
+	}
+
+	return types.PostOnlyModeFromString(postOnly)
+}
+
+// getDisplaySizeFlag returns the display size of an iceberg order, or nil for a regular order.
+func getDisplaySizeFlag(cmd *cobra.Command) (*sdk.Int, error) {
+	displaySize, err := cmd.Flags().GetString(flag_DisplaySize)
+	if err != nil || displaySize == "" {
+		return nil, err
+	}
+
+	size, ok := sdk.NewIntFromString(displaySize)
+	if !ok {
+		return nil, fmt.Errorf("invalid %v value: %v", flag_DisplaySize, displaySize)
+	}
+
+	return &size, nil
+}
+
+func addSelfTradePreventionFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flag_SelfTrade, "", flag_SelfTradeDescription)
+}
+
+func getSelfTradePreventionFlag(cmd *cobra.Command) (types.SelfTradePrevention, error) {
+	mode, err := cmd.Flags().GetString(flag_SelfTrade)
+	if err != nil {
+		return 0, err
+	}
+
+	return types.SelfTradePreventionFromString(mode)
+}
+
+func getExpiryFlags(cmd *cobra.Command) (*time.Time, int64, error) {
+	gtt, err := cmd.Flags().GetString(flag_GoodTillTime)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	gtb, err := cmd.Flags().GetInt64(flag_GoodTillBlock)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if gtt == "" {
+		return nil, gtb, nil
+	}
+
+	goodTillTime, err := time.Parse(time.RFC3339, gtt)
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid %v value: %w", flag_GoodTillTime, err)
+	}
+
+	return &goodTillTime, gtb, nil
+}
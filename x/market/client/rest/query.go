@@ -3,6 +3,7 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,6 +16,7 @@ func RegisterQueryRoutes(cliCtx client.Context, r *mux.Router) {
 	r.HandleFunc("/market/instruments", queryInstrumentsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/market/instrument/{src}/{dst}", queryInstrumentHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/market/account/{address}", queryByAccountHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/market/depth/{src}/{dst}", queryDepthHandlerFn(cliCtx)).Methods("GET")
 }
 
 func queryByAccountHandlerFn(cliCtx client.Context) http.HandlerFunc {
@@ -91,3 +93,38 @@ func queryInstrumentsHandlerFn(cliCtx client.Context) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func queryDepthHandlerFn(cliCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		src, dst := vars["src"], vars["dst"]
+
+		if sdk.ValidateDenom(src) != nil || sdk.ValidateDenom(dst) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, types.QueryDepth, src, dst)
+		if levels := r.URL.Query().Get("levels"); levels != "" {
+			if _, err := strconv.ParseUint(levels, 10, 32); err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			route = fmt.Sprintf("%s/%s", route, levels)
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
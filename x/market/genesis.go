@@ -0,0 +1,26 @@
+package market
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *types.GenesisState {
+	return types.DefaultGenesisState()
+}
+
+func InitGenesis(ctx sdk.Context, keeper *Keeper, data types.GenesisState) error {
+	return keeper.InitGenesis(ctx, data)
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper *Keeper) *types.GenesisState {
+	return keeper.ExportGenesis(ctx)
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data types.GenesisState) error {
+	return types.ValidateGenesis(data)
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+const (
+	// Balance changes are charged a fixed amount per account and denomination with orders, and per order that is
+	// re-evaluated, so that a transfer is charged the same regardless of the state of the stores.
+	gasPriceBalanceChange         = uint64(2500)
+	gasPriceBalanceChangePerOrder = uint64(500)
+
+	// Maximum number of orders re-evaluated when the balance of an account changes. The orders of an account and
+	// denomination beyond it are re-evaluated in EndBlock, and charged as if the maximum was re-evaluated.
+	maxBalanceChangeOrders = 50
+)
+
+// accountChanged updates any orders that can no longer be filled with the account's balance. Only orders with one of
+// the changed denominations as source are affected. The re-evaluation is charged to the gas meter of ctx.
+func (k *Keeper) accountChanged(ctx sdk.Context, accounts []sdk.AccAddress, denoms []string) {
+	gasMeter := ctx.GasMeter()
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	for _, acc := range accounts {
+		for _, denom := range denoms {
+			count := k.countOrdersByOwnerAndDenom(ctx, acc, denom, maxBalanceChangeOrders+1)
+			if count == 0 {
+				continue
+			}
+
+			if count > maxBalanceChangeOrders {
+				gasMeter.ConsumeGas(gasPriceBalanceChange+maxBalanceChangeOrders*gasPriceBalanceChangePerOrder, "BalanceChange")
+				ctx.KVStore(k.keyIndices).Set(types.GetPendingBalanceChangeKey(acc.String(), denom), []byte{1})
+				continue
+			}
+
+			gasMeter.ConsumeGas(gasPriceBalanceChange+uint64(count)*gasPriceBalanceChangePerOrder, "BalanceChange")
+			k.reevaluateOrders(ctx, acc, denom)
+		}
+	}
+}
+
+// reevaluateOrders adjusts the orders of the owner with the given source denomination to the owner's spendable balance.
+// The balance is allocated to the orders of each instrument in turn, so that the orders of an instrument never exceed
+// it in total. Orders that can no longer be filled are canceled.
+func (k *Keeper) reevaluateOrders(ctx sdk.Context, owner sdk.AccAddress, denom string) {
+	ctx.KVStore(k.keyIndices).Delete(types.GetPendingBalanceChangeKey(owner.String(), denom))
+
+	orders := k.getOrdersByOwnerAndDenom(ctx, owner, denom)
+	if len(orders) == 0 {
+		return
+	}
+
+	spendable := k.bk.SpendableCoins(ctx, owner).AmountOf(denom)
+	allocated := make(map[string]sdk.Int)
+
+	for _, order := range orders {
+		if _, found := allocated[order.Destination.Denom]; !found {
+			allocated[order.Destination.Denom] = sdk.ZeroInt()
+		}
+		denomBalance := spendable.Sub(allocated[order.Destination.Denom])
+
+		origSourceRemaining := order.SourceRemaining
+		order.SourceRemaining = order.Source.Amount.Sub(order.SourceFilled)
+		order.SourceRemaining = sdk.MinInt(order.SourceRemaining, denomBalance)
+		allocated[order.Destination.Denom] = allocated[order.Destination.Denom].Add(order.SourceRemaining)
+
+		if order.SourceRemaining.IsZero() {
+			types.EmitExpireEvent(ctx, *order, types.ExpireReason_InsufficientBalance)
+			k.deleteOrder(ctx, order)
+			k.recordOrder(ctx, *order, types.OrderStatus_Expired)
+		} else if !origSourceRemaining.Equal(order.SourceRemaining) {
+			types.EmitUpdateEvent(ctx, *order)
+			k.setOrder(ctx, order)
+		}
+	}
+}
+
+// hasPendingBalanceChange reports whether the orders of the owner with the given source denomination are yet to be
+// re-evaluated, in which case they may exceed the owner's balance.
+func (k Keeper) hasPendingBalanceChange(ctx sdk.Context, owner, denom string) bool {
+	return ctx.KVStore(k.keyIndices).Has(types.GetPendingBalanceChangeKey(owner, denom))
+}
+
+// settlePendingBalanceChanges re-evaluates the pending balance changes of the owners of the orders, which must be done
+// before the orders are matched. It reports whether any were re-evaluated, as the orders may have changed.
+func (k *Keeper) settlePendingBalanceChanges(ctx sdk.Context, orders []*types.Order) bool {
+	settled := false
+	for _, order := range orders {
+		if !k.hasPendingBalanceChange(ctx, order.Owner, order.Source.Denom) {
+			continue
+		}
+
+		k.reevaluateOrders(ctx, sdk.MustAccAddressFromBech32(order.Owner), order.Source.Denom)
+		settled = true
+	}
+
+	return settled
+}
+
+// ReevaluatePendingBalanceChanges re-evaluates the orders of all balance changes that exceeded the maximum number of
+// orders re-evaluated by a transfer.
+func (k *Keeper) ReevaluatePendingBalanceChanges(ctx sdk.Context) {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	// Collect the keys first, as the store cannot be modified while iterating.
+	var pendingKeys [][]byte
+
+	it := sdk.KVStorePrefixIterator(idxStore, types.GetPendingBalanceChangePrefix())
+	for ; it.Valid(); it.Next() {
+		pendingKeys = append(pendingKeys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range pendingKeys {
+		owner, denom := types.MustParsePendingBalanceChangeKey(key)
+		k.reevaluateOrders(ctx, sdk.MustAccAddressFromBech32(owner), denom)
+	}
+}
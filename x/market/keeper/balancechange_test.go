@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBalanceChangeOutOfGas(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "100eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "")
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+
+	require.NoError(t, (*bk.GetBankKeeper()).SendCoins(ctx, acc1.GetAddress(), acc2.GetAddress(), coins("50eur")))
+
+	// The balance change runs out of gas before any order is updated
+	gasMeter := sdk.NewGasMeter(gasPriceBalanceChange)
+	require.Panics(t, func() {
+		k.accountChanged(ctx.WithGasMeter(gasMeter), []sdk.AccAddress{acc1.GetAddress()}, []string{"eur"})
+	})
+
+	require.Equal(t, "100", k.GetOrdersByOwner(ctx, acc1.GetAddress())[0].SourceRemaining.String())
+}
+
+func TestPendingBalanceChange(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	const orderCount = maxBalanceChangeOrders + 10
+
+	maker := createAccount(ctx, ak, bk, randomAddress(), fmt.Sprintf("%deur", 10*orderCount))
+	other := createAccount(ctx, ak, bk, randomAddress(), "")
+	for i := 0; i < orderCount; i++ {
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), maker, "10eur", "10usd")))
+	}
+
+	// Leave the maker with a balance for 20 orders
+	require.NoError(t, bk.SendCoins(ctx, maker.GetAddress(), other.GetAddress(), coins(fmt.Sprintf("%deur", 10*(orderCount-20)))))
+	require.True(t, k.hasPendingBalanceChange(ctx, maker.GetAddress().String(), "eur"))
+	require.Len(t, k.GetOrdersByOwner(ctx, maker.GetAddress()), orderCount)
+
+	msg, broken := InstrumentDemandInvariant(k)(ctx)
+	require.False(t, broken, msg)
+
+	k.ReevaluatePendingBalanceChanges(ctx)
+
+	require.False(t, k.hasPendingBalanceChange(ctx, maker.GetAddress().String(), "eur"))
+	orders := k.GetOrdersByOwner(ctx, maker.GetAddress())
+	require.Len(t, orders, 20)
+	for _, o := range orders {
+		require.Equal(t, "10", o.SourceRemaining.String())
+	}
+
+	msg, broken = AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestInstrumentDemandOfPendingBalanceChange(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	const orderCount = maxBalanceChangeOrders + 10
+
+	maker := createAccount(ctx, ak, bk, randomAddress(), fmt.Sprintf("%deur", 10*orderCount))
+	other := createAccount(ctx, ak, bk, randomAddress(), "")
+	for i := 0; i < orderCount; i++ {
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), maker, "10eur", "10usd")))
+	}
+
+	require.NoError(t, bk.SendCoins(ctx, maker.GetAddress(), other.GetAddress(), coins(fmt.Sprintf("%deur", 10*(orderCount-20)))))
+
+	// The orders exceed the balance until EndBlock, which settles them
+	msg, broken := InstrumentDemandInvariant(k)(ctx)
+	require.False(t, broken, msg)
+	require.True(t, k.hasPendingBalanceChange(ctx, maker.GetAddress().String(), "eur"))
+	require.Len(t, k.GetOrdersByOwner(ctx, maker.GetAddress()), orderCount)
+
+	// An order that the re-evaluation misses breaks the invariant
+	o := k.GetOrdersByOwner(ctx, maker.GetAddress())[0]
+	ctx.KVStore(k.keyIndices).Delete(types.GetOwnerDenomKey(o.Owner, o.Source.Denom, o.ClientOrderID))
+
+	_, broken = InstrumentDemandInvariant(k)(ctx)
+	require.True(t, broken)
+}
+
+func TestPendingBalanceChangeSettledBeforeMatching(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	const orderCount = maxBalanceChangeOrders + 10
+
+	maker := createAccount(ctx, ak, bk, randomAddress(), fmt.Sprintf("%deur", 10*orderCount))
+	taker := createAccount(ctx, ak, bk, randomAddress(), "1000usd")
+	other := createAccount(ctx, ak, bk, randomAddress(), "")
+	for i := 0; i < orderCount; i++ {
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), maker, "10eur", "10usd")))
+	}
+
+	require.NoError(t, bk.SendCoins(ctx, maker.GetAddress(), other.GetAddress(), coins(fmt.Sprintf("%deur", 10*(orderCount-20)))))
+	require.True(t, k.hasPendingBalanceChange(ctx, maker.GetAddress().String(), "eur"))
+
+	// The maker's orders are re-evaluated before they are matched, so the taker only buys what the maker holds
+	gasMeter := sdk.NewGasMeter(1000000)
+	require.NoError(t, k.NewOrderSingle(ctx.WithGasMeter(gasMeter), order(ctx.BlockTime(), taker, "300usd", "300eur")))
+	require.Equal(t, gasPriceNewOrder, gasMeter.GasConsumed())
+
+	require.False(t, k.hasPendingBalanceChange(ctx, maker.GetAddress().String(), "eur"))
+	require.Equal(t, "200eur,800usd", bk.GetAllBalances(ctx, taker.GetAddress()).String())
+	require.Equal(t, "200usd", bk.GetAllBalances(ctx, maker.GetAddress()).String())
+	require.Empty(t, k.GetOrdersByOwner(ctx, maker.GetAddress()))
+
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// HaltTrading suspends trading in both directions of the src/dst instrument on behalf of the authority. Resting orders
+// remain in the book, but are not matched until trading is resumed.
+func (k *Keeper) HaltTrading(ctx sdk.Context, src, dst string) error {
+	if src == dst {
+		return sdkerrors.Wrapf(types.ErrInvalidInstrument, "%v/%v", src, dst)
+	}
+
+	if k.GetTradingHalt(ctx, src, dst) != nil {
+		return sdkerrors.Wrapf(types.ErrTradingHalted, "%v/%v", src, dst)
+	}
+
+	k.haltTrading(ctx, src, dst, false)
+	return nil
+}
+
+// ResumeTrading resumes trading in both directions of the src/dst instrument, whether it was halted by the authority
+// or by a circuit breaker. The price band of the instrument starts over with a new window.
+func (k *Keeper) ResumeTrading(ctx sdk.Context, src, dst string) error {
+	if k.GetTradingHalt(ctx, src, dst) == nil {
+		return sdkerrors.Wrapf(types.ErrTradingNotHalted, "%v/%v", src, dst)
+	}
+
+	idxStore := ctx.KVStore(k.keyIndices)
+	idxStore.Delete(types.GetTradingHaltKey(src, dst))
+	idxStore.Delete(types.GetPriceBandKey(src, dst))
+
+	types.EmitResumeEvent(ctx, src, dst)
+	return nil
+}
+
+func (k Keeper) haltTrading(ctx sdk.Context, src, dst string, automatic bool) {
+	halt := types.TradingHalt{
+		Source:      src,
+		Destination: dst,
+		Halted:      ctx.BlockTime(),
+		Automatic:   automatic,
+	}
+
+	k.setTradingHalt(ctx, halt)
+	types.EmitHaltEvent(ctx, halt)
+}
+
+func (k Keeper) setTradingHalt(ctx sdk.Context, halt types.TradingHalt) {
+	idxStore := ctx.KVStore(k.keyIndices)
+	idxStore.Set(types.GetTradingHaltKey(halt.Source, halt.Destination), k.cdc.MustMarshal(&halt))
+}
+
+// GetTradingHalt returns the halt of the src/dst instrument in either direction, or nil if it is traded.
+func (k Keeper) GetTradingHalt(ctx sdk.Context, src, dst string) *types.TradingHalt {
+	bz := ctx.KVStore(k.keyIndices).Get(types.GetTradingHaltKey(src, dst))
+	if bz == nil {
+		return nil
+	}
+
+	halt := new(types.TradingHalt)
+	k.cdc.MustUnmarshal(bz, halt)
+	return halt
+}
+
+// GetTradingHalts returns all halted instruments.
+func (k Keeper) GetTradingHalts(ctx sdk.Context) []types.TradingHalt {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	it := sdk.KVStorePrefixIterator(idxStore, types.GetTradingHaltPrefix())
+	defer it.Close()
+
+	halts := make([]types.TradingHalt, 0)
+	for ; it.Valid(); it.Next() {
+		var halt types.TradingHalt
+		k.cdc.MustUnmarshal(it.Value(), &halt)
+		halts = append(halts, halt)
+	}
+
+	return halts
+}
+
+// getPriceBand returns the state of the circuit breaker cb without storing it. A new window, with the last price of the
+// breaker's instrument as reference price, is returned once the window has passed, or while the instrument has not
+// traded. The window is stored by setPriceBands once a plan has been matched.
+func (k Keeper) getPriceBand(ctx sdk.Context, cb types.CircuitBreaker) types.PriceBand {
+	var band types.PriceBand
+	if bz := ctx.KVStore(k.keyIndices).Get(types.GetPriceBandKey(cb.Source, cb.Destination)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &band)
+	}
+
+	// The band is also reset if the circuit breaker has been reconfigured for the opposite direction.
+	if band.ReferencePrice != nil && band.Source == cb.Source && band.Destination == cb.Destination &&
+		ctx.BlockTime().Before(band.WindowStart.Add(cb.Window)) {
+		return band
+	}
+
+	band = types.PriceBand{
+		Source:      cb.Source,
+		Destination: cb.Destination,
+		WindowStart: ctx.BlockTime(),
+	}
+
+	if md := k.GetInstrument(ctx, cb.Source, cb.Destination); md != nil && md.LastPrice != nil && md.LastPrice.IsPositive() {
+		band.ReferencePrice = md.LastPrice
+	}
+
+	return band
+}
+
+func (k Keeper) setPriceBand(ctx sdk.Context, band types.PriceBand) {
+	idxStore := ctx.KVStore(k.keyIndices)
+	idxStore.Set(types.GetPriceBandKey(band.Source, band.Destination), k.cdc.MustMarshal(&band))
+}
+
+// setPriceBands stores the bands returned by planPriceBands after their plan has been matched, which starts the
+// windows of the bands that have rolled over. Bands without a reference price are not stored, as they are reset until
+// the instrument has traded.
+func (k Keeper) setPriceBands(ctx sdk.Context, bands []types.PriceBand) {
+	for _, band := range bands {
+		if band.ReferencePrice != nil {
+			k.setPriceBand(ctx, band)
+		}
+	}
+}
+
+// priceBandLimits returns the lowest and highest price, stated as destination per source, at which the src/dst
+// instrument may trade. Found is false if the instrument has no circuit breaker or no reference price.
+func (k Keeper) priceBandLimits(ctx sdk.Context, params types.Params, src, dst string) (lower, upper sdk.Dec, found bool) {
+	cb, inverted, found := params.GetCircuitBreaker(src, dst)
+	if !found {
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+
+	band := k.getPriceBand(ctx, cb)
+	if band.ReferencePrice == nil {
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+
+	lower = band.ReferencePrice.Mul(sdk.OneDec().Sub(cb.MaxDeviation))
+	upper = band.ReferencePrice.Mul(sdk.OneDec().Add(cb.MaxDeviation))
+	if !lower.IsPositive() {
+		// The reference price is too small for the band to be represented.
+		return sdk.Dec{}, sdk.Dec{}, false
+	}
+
+	if inverted {
+		lower, upper = sdk.OneDec().Quo(upper), sdk.OneDec().Quo(lower)
+	}
+
+	return lower, upper, true
+}
+
+type planTrade struct {
+	source, destination string
+	price               sdk.Dec
+}
+
+// planTrades returns the instruments traded by executing plan for aggressiveOrder, with the price of each trade.
+func planTrades(aggressiveOrder types.Order, plan types.ExecutionPlan) []planTrade {
+	trades := []planTrade{{aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom, plan.Price}}
+	for _, passiveOrder := range plan.Orders {
+		trades = append(trades, planTrade{passiveOrder.Source.Denom, passiveOrder.Destination.Denom, passiveOrder.Price()})
+	}
+
+	return trades
+}
+
+// priceBandBreaches returns the circuit breakers whose band would be breached by executing plan for aggressiveOrder.
+// Both the price of the aggressive order's instrument and the prices of the passive orders' instruments are verified.
+func (k Keeper) priceBandBreaches(ctx sdk.Context, params types.Params, aggressiveOrder types.Order, plan types.ExecutionPlan) []types.CircuitBreaker {
+	var breached []types.CircuitBreaker
+	seen := make(map[string]bool)
+	for _, t := range planTrades(aggressiveOrder, plan) {
+		lower, upper, found := k.priceBandLimits(ctx, params, t.source, t.destination)
+		if !found || (t.price.GTE(lower) && t.price.LTE(upper)) {
+			continue
+		}
+
+		// A direct plan trades the aggressive and the passive instrument in opposite directions of the same breaker.
+		key := string(types.GetPriceBandKey(t.source, t.destination))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		cb, _, _ := params.GetCircuitBreaker(t.source, t.destination)
+		breached = append(breached, cb)
+	}
+
+	return breached
+}
+
+// planPriceBands returns the current bands of the circuit breakers of the instruments traded by plan for
+// aggressiveOrder. They are read before the plan is settled, so that a band that rolls over takes the last price
+// before the match as reference price.
+func (k Keeper) planPriceBands(ctx sdk.Context, params types.Params, aggressiveOrder types.Order, plan types.ExecutionPlan) []types.PriceBand {
+	var bands []types.PriceBand
+	seen := make(map[string]bool)
+	for _, t := range planTrades(aggressiveOrder, plan) {
+		cb, _, found := params.GetCircuitBreaker(t.source, t.destination)
+		key := string(types.GetPriceBandKey(t.source, t.destination))
+		if !found || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		bands = append(bands, k.getPriceBand(ctx, cb))
+	}
+
+	return bands
+}
+
+// recordPriceBandBreaches counts a breach of each circuit breaker within its current window and halts the instruments
+// whose breakers have been breached too often.
+func (k Keeper) recordPriceBandBreaches(ctx sdk.Context, breakers []types.CircuitBreaker) {
+	for _, cb := range breakers {
+		band := k.getPriceBand(ctx, cb)
+		band.Breaches++
+		k.setPriceBand(ctx, band)
+
+		if cb.MaxBreaches > 0 && band.Breaches >= cb.MaxBreaches && k.GetTradingHalt(ctx, cb.Source, cb.Destination) == nil {
+			k.haltTrading(ctx, cb.Source, cb.Destination, true)
+		}
+	}
+}
+
+// getHaltedInstruments returns the trading halt keys of the halted instruments.
+func (k Keeper) getHaltedInstruments(ctx sdk.Context) map[string]bool {
+	halted := make(map[string]bool)
+	for _, halt := range k.GetTradingHalts(ctx) {
+		halted[string(types.GetTradingHaltKey(halt.Source, halt.Destination))] = true
+	}
+
+	return halted
+}
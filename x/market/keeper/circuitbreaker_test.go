@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setCircuitBreaker(ctx sdk.Context, k *Keeper, maxBreaches uint32) {
+	params := k.GetParams(ctx)
+	params.CircuitBreakers = []types.CircuitBreaker{{
+		Source:       "eur",
+		Destination:  "usd",
+		MaxDeviation: sdk.NewDecWithPrec(1, 1),
+		Window:       time.Hour,
+		MaxBreaches:  maxBreaches,
+	}}
+	k.SetParams(ctx, params)
+}
+
+func setLastPrice(ctx sdk.Context, k *Keeper, src, dst, price string) {
+	p := sdk.MustNewDecFromStr(price)
+	k.setMarketData(ctx, src, dst, p)
+	k.setMarketData(ctx, dst, src, sdk.OneDec().Quo(p))
+}
+
+func TestPriceBandRejectsOrders(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	setCircuitBreaker(ctx, k, 0)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	// Without a last price, the instrument has no band.
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "50usd")))
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), k.GetOrdersByOwner(ctx, acc1.GetAddress())[0].ClientOrderID))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	setLastPrice(ctx, k, "eur", "usd", "1.2")
+
+	// The band of eur/usd is [1.08; 1.32]
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd"))
+	require.ErrorIs(t, err, types.ErrPriceOutsideBand)
+
+	// Orders priced above the band are placed in the book
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "150usd")))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+
+	// The band applies to the inverted instrument as well
+	err = k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "70eur"))
+	require.ErrorIs(t, err, types.ErrPriceOutsideBand)
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "80eur")))
+
+	// Instruments without a circuit breaker are unaffected
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "5000chf")
+	setLastPrice(ctx, k, "chf", "usd", "1.2")
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "100chf", "10usd")))
+}
+
+func TestPriceBandHaltsTrading(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	setCircuitBreaker(ctx, k, 2)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	// A passive order placed before the band existed, which is now far below it
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd")))
+	setLastPrice(ctx, k, "eur", "usd", "1.2")
+
+	priceBandExpiries := func(ctx sdk.Context) int {
+		return len(filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyReason, types.ExpireReason_PriceBand.AttributeValue()))
+	}
+
+	// The aggressive order is canceled rather than matched outside of the band
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "110usd", "100eur")))
+	require.Equal(t, 1, priceBandExpiries(ctx))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+	require.Equal(t, "5000usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+	require.Nil(t, k.GetTradingHalt(ctx, "eur", "usd"))
+
+	// Breaches are counted within the window only
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "110usd", "100eur")))
+	require.Nil(t, k.GetTradingHalt(ctx, "eur", "usd"))
+
+	// Breaches by killed orders count as well
+	fok := order(ctx.BlockTime(), acc2, "110usd", "100eur")
+	fok.TimeInForce = types.TimeInForce_FillOrKill
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.NewOrderSingle(ctx, fok))
+
+	halt := k.GetTradingHalt(ctx, "usd", "eur")
+	require.NotNil(t, halt)
+	require.True(t, halt.Automatic)
+	require.Len(t, filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "halt"), 1)
+
+	// Halted instruments can be traded in neither direction, directly or synthetically
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "150usd", "100eur"))
+	require.ErrorIs(t, err, types.ErrTradingHalted)
+	err = k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "150usd"))
+	require.ErrorIs(t, err, types.ErrTradingHalted)
+	require.Nil(t, k.GetBestPrice(ctx, "usd", "eur"))
+
+	require.ErrorIs(t, k.HaltTrading(ctx, "eur", "usd"), types.ErrTradingHalted)
+
+	// Trading resumes with a new window
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.ResumeTrading(ctx, "eur", "usd"))
+	require.Len(t, filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "resume"), 1)
+	require.ErrorIs(t, k.ResumeTrading(ctx, "eur", "usd"), types.ErrTradingNotHalted)
+
+	stale := k.GetOrdersByOwner(ctx, acc1.GetAddress())[0]
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), stale.ClientOrderID))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "85eur")))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 1)
+}
+
+func TestHaltTrading(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	require.ErrorIs(t, k.HaltTrading(ctx, "eur", "eur"), types.ErrInvalidInstrument)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.HaltTrading(ctx, "usd", "eur"))
+
+	halt := k.GetTradingHalt(ctx, "eur", "usd")
+	require.NotNil(t, halt)
+	require.False(t, halt.Automatic)
+	require.Len(t, k.GetTradingHalts(ctx), 1)
+
+	// Resting orders remain in the book, but are not matched
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur"))
+	require.ErrorIs(t, err, types.ErrTradingHalted)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+
+	require.NoError(t, k.ResumeTrading(ctx, "eur", "usd"))
+	require.Empty(t, k.GetTradingHalts(ctx))
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+}
+
+func TestPriceBandWindowStartsOnMatch(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	setCircuitBreaker(ctx, k, 0)
+	setLastPrice(ctx, k, "eur", "usd", "1.2")
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	storedBand := func(ctx sdk.Context) *types.PriceBand {
+		bz := ctx.KVStore(k.keyIndices).Get(types.GetPriceBandKey("eur", "usd"))
+		if bz == nil {
+			return nil
+		}
+
+		band := new(types.PriceBand)
+		k.cdc.MustUnmarshal(bz, band)
+		return band
+	}
+
+	// Neither rejected nor resting orders start a window
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd"))
+	require.ErrorIs(t, err, types.ErrPriceOutsideBand)
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "125usd")))
+	require.Nil(t, storedBand(ctx))
+
+	// The window starts with the last price before the match
+	start := ctx.BlockTime()
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "125usd", "100eur")))
+	band := storedBand(ctx)
+	require.NotNil(t, band)
+	require.Equal(t, sdk.MustNewDecFromStr("1.2"), *band.ReferencePrice)
+	require.True(t, start.Equal(band.WindowStart))
+
+	// A rejected order does not roll over the window once it has passed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	err = k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100usd"))
+	require.ErrorIs(t, err, types.ErrPriceOutsideBand)
+	require.True(t, start.Equal(storedBand(ctx).WindowStart))
+
+	// The next match does, with the price of the previous match as reference price
+	lastPrice := k.GetInstrument(ctx, "eur", "usd").LastPrice
+	require.True(t, lastPrice.GT(sdk.MustNewDecFromStr("1.2")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "125usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "125usd", "100eur")))
+	band = storedBand(ctx)
+	require.Equal(t, *lastPrice, *band.ReferencePrice)
+	require.True(t, ctx.BlockTime().Equal(band.WindowStart))
+}
@@ -0,0 +1,258 @@
+package keeper
+
+import (
+	"math"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+const (
+	gasPriceAddConditionalOrder = uint64(25000)
+
+	// A triggered order is charged to the transaction that triggered it like a new order.
+	gasPriceTriggeredOrder = uint64(25000)
+
+	// Maximum number of conditional orders placed by a single order, including the orders triggered in turn. A
+	// transaction with several orders, such as a batch, places up to this number for each. Triggered orders beyond it
+	// are left pending and placed in EndBlock.
+	maxTriggeredOrdersPerOrder = 10
+)
+
+// Context key set while a triggered conditional order is placed, as triggers are processed by the outermost order only.
+type triggeredOrderKey struct{}
+
+// AddConditionalOrder stores a conditional order until the last traded price of its instrument meets its condition.
+// The order is validated as usual, but the account balance is only verified once the order is placed.
+func (k *Keeper) AddConditionalOrder(ctx sdk.Context, co types.ConditionalOrder) error {
+	// Use a fixed gas amount
+	ctx.GasMeter().ConsumeGas(gasPriceAddConditionalOrder, "AddConditionalOrder")
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	if err := co.Validate(); err != nil {
+		return err
+	}
+
+	if co.Order.IsFilled() {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidPrice, "Order price is invalid: %s -> %s",
+			co.Order.Source, co.Order.Destination,
+		)
+	}
+
+	if co.Order.IsExpired(ctx.BlockTime(), ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(types.ErrInvalidExpiry, "Order has already expired")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(co.Order.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	if k.GetOrderByOwnerAndClientOrderId(ctx, co.Order.Owner, co.Order.ClientOrderID) != nil ||
+		k.GetConditionalOrder(ctx, co.Order.Owner, co.Order.ClientOrderID) != nil {
+		return sdkerrors.Wrap(types.ErrNonUniqueClientOrderId, co.Order.ClientOrderID)
+	}
+
+	if !k.assetExists(ctx, co.Order.Destination) {
+		return sdkerrors.Wrap(types.ErrUnknownAsset, co.Order.Destination.Denom)
+	}
+
+	if md := k.GetInstrument(ctx, co.Order.Source.Denom, co.Order.Destination.Denom); md != nil && md.LastPrice != nil && co.IsTriggeredBy(*md.LastPrice) {
+		return sdkerrors.Wrapf(types.ErrConditionAlreadyMet, "Last price %v, trigger price %v", md.LastPrice, co.TriggerPrice)
+	}
+
+	co.Order.ID = k.getNextOrderNumber(ctx)
+	k.setConditionalOrder(ctx, &co)
+	types.EmitConditionalAcceptEvent(ctx, co)
+
+	return nil
+}
+
+func (k Keeper) GetConditionalOrder(ctx sdk.Context, owner, clientOrderId string) *types.ConditionalOrder {
+	bz := ctx.KVStore(k.key).Get(types.GetConditionalOrderKey(owner, clientOrderId))
+	if bz == nil {
+		return nil
+	}
+
+	co := new(types.ConditionalOrder)
+	k.cdc.MustUnmarshal(bz, co)
+	return co
+}
+
+// GetConditionalOrdersByOwner returns the pending conditional orders of owner, sorted by client order id.
+func (k Keeper) GetConditionalOrdersByOwner(ctx sdk.Context, owner sdk.AccAddress, pageReq *query.PageRequest) ([]types.ConditionalOrder, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.GetConditionalOrderKey(owner.String(), ""))
+
+	orders := make([]types.ConditionalOrder, 0)
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		var co types.ConditionalOrder
+		if err := k.cdc.Unmarshal(value, &co); err != nil {
+			return err
+		}
+
+		orders = append(orders, co)
+		return nil
+	})
+
+	return orders, pageRes, err
+}
+
+// GetAllConditionalOrders returns every pending conditional order, sorted by owner and client order id.
+func (k Keeper) GetAllConditionalOrders(ctx sdk.Context) (res []types.ConditionalOrder) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.GetConditionalOrderPrefix())
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		var co types.ConditionalOrder
+		k.cdc.MustUnmarshal(it.Value(), &co)
+		res = append(res, co)
+	}
+
+	return
+}
+
+func (k Keeper) setConditionalOrder(ctx sdk.Context, co *types.ConditionalOrder) {
+	var (
+		store    = ctx.KVStore(k.key)
+		idxStore = ctx.KVStore(k.keyIndices)
+	)
+
+	key := types.GetConditionalOrderKey(co.Order.Owner, co.Order.ClientOrderID)
+	store.Set(key, k.cdc.MustMarshal(co))
+	idxStore.Set(types.GetTriggerKey(co), key)
+
+	// Conditional orders share the expiry index with the orders in the book.
+	if expiryKey := types.GetExpiryKey(&co.Order); expiryKey != nil {
+		idxStore.Set(expiryKey, key)
+	}
+}
+
+func (k Keeper) deleteConditionalOrder(ctx sdk.Context, co *types.ConditionalOrder) {
+	var (
+		store    = ctx.KVStore(k.key)
+		idxStore = ctx.KVStore(k.keyIndices)
+	)
+
+	store.Delete(types.GetConditionalOrderKey(co.Order.Owner, co.Order.ClientOrderID))
+	idxStore.Delete(types.GetTriggerKey(co))
+	if expiryKey := types.GetExpiryKey(&co.Order); expiryKey != nil {
+		idxStore.Delete(expiryKey)
+	}
+}
+
+// cancelConditionalOrder removes a pending conditional order. Returns false if no such order exists.
+func (k Keeper) cancelConditionalOrder(ctx sdk.Context, owner, clientOrderId string) bool {
+	co := k.GetConditionalOrder(ctx, owner, clientOrderId)
+	if co == nil {
+		return false
+	}
+
+	k.deleteConditionalOrder(ctx, co)
+	types.EmitConditionalCancelEvent(ctx, *co)
+	return true
+}
+
+// nextTriggeredOrder returns the first conditional order of the instrument whose condition is met by lastPrice, or nil.
+// Stop-loss orders are returned before take-profit orders, and orders are returned in the order in which their trigger
+// prices were crossed.
+func (k Keeper) nextTriggeredOrder(ctx sdk.Context, src, dst string, lastPrice sdk.Dec) *types.ConditionalOrder {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	// Stop-loss orders with a trigger price at or above the last price, highest first.
+	stopLossPrefix := types.GetTriggerKeyPrefix(src, dst, types.ConditionType_StopLoss)
+	it := idxStore.ReverseIterator(types.GetTriggerPriceKey(src, dst, types.ConditionType_StopLoss, lastPrice), sdk.PrefixEndBytes(stopLossPrefix))
+	ownerKey := firstValue(it)
+
+	if ownerKey == nil {
+		// Take-profit orders with a trigger price at or below the last price, lowest first.
+		takeProfitPrefix := types.GetTriggerKeyPrefix(src, dst, types.ConditionType_TakeProfit)
+		it = idxStore.Iterator(takeProfitPrefix, sdk.PrefixEndBytes(types.GetTriggerPriceKey(src, dst, types.ConditionType_TakeProfit, lastPrice)))
+		ownerKey = firstValue(it)
+	}
+
+	if ownerKey == nil {
+		return nil
+	}
+
+	co := new(types.ConditionalOrder)
+	k.cdc.MustUnmarshal(ctx.KVStore(k.key).Get(ownerKey), co)
+	return co
+}
+
+func firstValue(it sdk.Iterator) []byte {
+	defer it.Close()
+
+	if !it.Valid() {
+		return nil
+	}
+
+	return it.Value()
+}
+
+// recordTriggers marks the instrument for trigger processing if the last price meets the condition of any of its
+// conditional orders.
+func (k Keeper) recordTriggers(ctx sdk.Context, src, dst string, lastPrice sdk.Dec) {
+	if k.nextTriggeredOrder(ctx, src, dst, lastPrice) != nil {
+		ctx.KVStore(k.keyIndices).Set(types.GetPendingTriggerKey(src, dst), []byte{1})
+	}
+}
+
+// TriggerPendingConditionalOrders places the conditional orders of all instruments marked by recordTriggers, including
+// those that exceeded the maximum number of orders triggered by a single order.
+func (k *Keeper) TriggerPendingConditionalOrders(ctx sdk.Context) {
+	k.triggerConditionalOrders(ctx, sdk.NewInfiniteGasMeter(), math.MaxInt32)
+}
+
+// triggerConditionalOrders places up to max conditional orders of the instruments marked by recordTriggers, each
+// charged to gasMeter. Placing an order may trade and trigger further conditional orders, which are placed in turn.
+// Triggers beyond max are left pending.
+func (k *Keeper) triggerConditionalOrders(ctx sdk.Context, gasMeter sdk.GasMeter, max int) {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	for triggered := 0; triggered < max; {
+		it := sdk.KVStorePrefixIterator(idxStore, types.GetPendingTriggerPrefix())
+		var pendingKey []byte
+		if it.Valid() {
+			pendingKey = it.Key()
+		}
+		it.Close()
+
+		if pendingKey == nil {
+			return
+		}
+
+		src, dst := types.MustParsePendingTriggerKey(pendingKey)
+
+		var co *types.ConditionalOrder
+		if md := k.GetInstrument(ctx, src, dst); md != nil && md.LastPrice != nil {
+			co = k.nextTriggeredOrder(ctx, src, dst, *md.LastPrice)
+		}
+
+		if co == nil {
+			idxStore.Delete(pendingKey)
+			continue
+		}
+
+		gasMeter.ConsumeGas(gasPriceTriggeredOrder, "TriggeredOrder")
+		triggered++
+
+		k.deleteConditionalOrder(ctx, co)
+
+		// The order is created when it is triggered.
+		order := co.Order
+		order.Created = ctx.BlockTime()
+
+		orderCtx := ctx.WithEventManager(sdk.NewEventManager()).WithValue(triggeredOrderKey{}, true)
+		err := k.NewOrderSingle(orderCtx, order)
+
+		types.EmitTriggerEvent(ctx, *co, err)
+		ctx.EventManager().EmitEvents(orderCtx.EventManager().Events())
+	}
+}
+
+func isTriggeredOrder(ctx sdk.Context) bool {
+	return ctx.Value(triggeredOrderKey{}) != nil
+}
@@ -0,0 +1,239 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func conditionalOrder(ctx sdk.Context, account authtypes.AccountI, src, dst string, condition types.ConditionType, triggerPrice string) types.ConditionalOrder {
+	return types.NewConditionalOrder(order(ctx.BlockTime(), account, src, dst), condition, sdk.MustNewDecFromStr(triggerPrice))
+}
+
+func TestConditionalOrderTriggers(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc4 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "110usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "105usd", "100eur")))
+
+	stopLoss := conditionalOrder(ctx, acc3, "100eur", "100usd", types.ConditionType_StopLoss, "1.15")
+	takeProfit := conditionalOrder(ctx, acc3, "50eur", "75usd", types.ConditionType_TakeProfit, "1.5")
+	cascading := conditionalOrder(ctx, acc4, "100eur", "90usd", types.ConditionType_StopLoss, "1.06")
+	require.NoError(t, k.AddConditionalOrder(ctx, stopLoss))
+	require.NoError(t, k.AddConditionalOrder(ctx, takeProfit))
+	require.NoError(t, k.AddConditionalOrder(ctx, cascading))
+
+	// Conditional orders are neither in the book nor reserve any balance
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc3.GetAddress()))
+	require.Len(t, k.GetAllConditionalOrders(ctx), 3)
+
+	// Trading eur at 1.1 usd triggers the stop-loss at 1.15, which trades at 1.05 and triggers the stop-loss at 1.06
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "110usd", "100eur")))
+
+	require.Equal(t, "4905eur,100usd", bk.GetAllBalances(ctx, acc3.GetAddress()).String())
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc3.GetAddress()))
+
+	orders := k.GetOrdersByOwner(ctx, acc4.GetAddress())
+	require.Len(t, orders, 1)
+	require.Equal(t, cascading.Order.ClientOrderID, orders[0].ClientOrderID)
+	require.Equal(t, coin("100eur"), orders[0].Source)
+
+	lastPrice := k.GetInstrument(ctx, "eur", "usd").LastPrice
+	require.True(t, lastPrice.LT(sdk.MustNewDecFromStr("1.06")), lastPrice)
+
+	triggers := filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "trigger")
+	require.Len(t, triggers, 2)
+	clientOrderID, _ := getEventAttrValue(triggers[0], types.AttributeKeyClientOrderID)
+	require.Equal(t, stopLoss.Order.ClientOrderID, clientOrderID)
+	clientOrderID, _ = getEventAttrValue(triggers[1], types.AttributeKeyClientOrderID)
+	require.Equal(t, cascading.Order.ClientOrderID, clientOrderID)
+
+	// The take-profit order is still pending
+	remaining := k.GetAllConditionalOrders(ctx)
+	require.Len(t, remaining, 1)
+	require.Equal(t, takeProfit.Order.ClientOrderID, remaining[0].Order.ClientOrderID)
+}
+
+func TestConditionalOrderTriggersBoundedPerOrder(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "110usd")))
+
+	// Take-profit orders whose limit price keeps them in the book once they are placed
+	for i := 0; i < maxTriggeredOrdersPerOrder+2; i++ {
+		require.NoError(t, k.AddConditionalOrder(ctx, conditionalOrder(ctx, acc3, "10eur", "100usd", types.ConditionType_TakeProfit, "1.05")))
+	}
+
+	// The trade triggers all conditional orders, but only the maximum per order is placed and charged to the transaction
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "110usd", "100eur")))
+	require.Equal(t, gasPriceNewOrder+maxTriggeredOrdersPerOrder*gasPriceTriggeredOrder, ctx.GasMeter().GasConsumed())
+	require.Len(t, filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "trigger"), maxTriggeredOrdersPerOrder)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc3.GetAddress()), maxTriggeredOrdersPerOrder)
+	require.Len(t, k.GetAllConditionalOrders(ctx), 2)
+	require.NotNil(t, ctx.KVStore(k.keyIndices).Get(types.GetPendingTriggerKey("eur", "usd")))
+
+	// The remaining orders are placed in EndBlock
+	k.TriggerPendingConditionalOrders(ctx)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc3.GetAddress()), maxTriggeredOrdersPerOrder+2)
+	require.Empty(t, k.GetAllConditionalOrders(ctx))
+	require.Nil(t, ctx.KVStore(k.keyIndices).Get(types.GetPendingTriggerKey("eur", "usd")))
+}
+
+func TestConditionalOrderTriggeredByCancelReplace(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "110usd")))
+	bid := order(ctx.BlockTime(), acc2, "100usd", "100eur")
+	require.NoError(t, k.NewOrderSingle(ctx, bid))
+	require.NoError(t, k.AddConditionalOrder(ctx, conditionalOrder(ctx, acc3, "50eur", "40usd", types.ConditionType_StopLoss, "1.15")))
+
+	// The replacement trades at 1.1 and triggers the stop-loss, which is charged like a trigger by a new order
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.CancelReplaceLimitOrder(ctx, order(ctx.BlockTime(), acc2, "110usd", "100eur"), bid.ClientOrderID))
+	require.Equal(t, gasPriceCancelReplaceOrder+gasPriceTriggeredOrder, ctx.GasMeter().GasConsumed())
+	require.Len(t, filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "trigger"), 1)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc3.GetAddress()), 1)
+	require.Empty(t, k.GetAllConditionalOrders(ctx))
+}
+
+func TestConditionalOrderTriggerFailure(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "50eur")
+
+	// The balance is only verified once the order is triggered
+	co := conditionalOrder(ctx, acc3, "100eur", "100usd", types.ConditionType_StopLoss, "1.5")
+	require.NoError(t, k.AddConditionalOrder(ctx, co))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+
+	triggers := filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "trigger")
+	require.Len(t, triggers, 1)
+	errMsg, found := getEventAttrValue(triggers[0], types.AttributeKeyError)
+	require.True(t, found)
+	require.Contains(t, errMsg, types.ErrAccountBalanceInsufficient.Error())
+
+	require.Empty(t, k.GetAllConditionalOrders(ctx))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc3.GetAddress()))
+	require.Equal(t, "50eur", bk.GetAllBalances(ctx, acc3.GetAddress()).String())
+}
+
+func TestAddConditionalOrderRejected(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+
+	// The last price of eur/usd is 1.2
+	err := k.AddConditionalOrder(ctx, conditionalOrder(ctx, acc1, "100eur", "100usd", types.ConditionType_StopLoss, "1.2"))
+	require.ErrorIs(t, err, types.ErrConditionAlreadyMet)
+
+	err = k.AddConditionalOrder(ctx, conditionalOrder(ctx, acc1, "100eur", "100usd", types.ConditionType_TakeProfit, "1.1"))
+	require.ErrorIs(t, err, types.ErrConditionAlreadyMet)
+
+	err = k.AddConditionalOrder(ctx, conditionalOrder(ctx, acc1, "100eur", "100usd", types.ConditionType_Unspecified, "1.1"))
+	require.ErrorIs(t, err, types.ErrInvalidConditionalOrder)
+
+	err = k.AddConditionalOrder(ctx, conditionalOrder(ctx, acc1, "100eur", "100xxx", types.ConditionType_StopLoss, "1.1"))
+	require.ErrorIs(t, err, types.ErrUnknownAsset)
+
+	// Client order ids are unique among active and conditional orders
+	active := k.GetOrdersByOwner(ctx, acc1.GetAddress())[0]
+	co := conditionalOrder(ctx, acc1, "100eur", "100usd", types.ConditionType_StopLoss, "1.1")
+	co.Order.ClientOrderID = active.ClientOrderID
+	require.ErrorIs(t, k.AddConditionalOrder(ctx, co), types.ErrNonUniqueClientOrderId)
+
+	co = conditionalOrder(ctx, acc1, "100eur", "100usd", types.ConditionType_StopLoss, "1.1")
+	require.NoError(t, k.AddConditionalOrder(ctx, co))
+	require.ErrorIs(t, k.AddConditionalOrder(ctx, co), types.ErrNonUniqueClientOrderId)
+
+	o := order(ctx.BlockTime(), acc1, "100eur", "130usd")
+	o.ClientOrderID = co.Order.ClientOrderID
+	require.ErrorIs(t, k.NewOrderSingle(ctx, o), types.ErrNonUniqueClientOrderId)
+}
+
+func TestCancelConditionalOrder(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	co := conditionalOrder(ctx, acc1, "100eur", "100usd", types.ConditionType_StopLoss, "1.5")
+	require.NoError(t, k.AddConditionalOrder(ctx, co))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), co.Order.ClientOrderID))
+	require.Len(t, filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "cancel_conditional"), 1)
+	require.Empty(t, k.GetAllConditionalOrders(ctx))
+
+	err := k.CancelOrder(ctx, acc1.GetAddress(), co.Order.ClientOrderID)
+	require.ErrorIs(t, err, types.ErrClientOrderIdNotFound)
+
+	// The canceled order is not triggered
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+	require.Empty(t, filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "trigger"))
+	require.Equal(t, "4900eur,120usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+}
+
+func TestConditionalOrdersGenesisAndQuery(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, k.AddConditionalOrder(ctx, conditionalOrder(ctx, acc1, "100eur", "100usd", types.ConditionType_StopLoss, "1.1")))
+	}
+
+	exported := k.ExportGenesis(ctx)
+	require.NoError(t, types.ValidateGenesis(*exported))
+	require.Len(t, exported.ConditionalOrders, 3)
+
+	ctx2, k2, _, _ := createTestComponentsWithEncoding(t, enc)
+	require.NoError(t, k2.InitGenesis(ctx2, *exported))
+	require.Equal(t, exported, k2.ExportGenesis(ctx2))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx2, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k2)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	res, err := queryClient.ConditionalOrders(ctx2.Context(), &types.QueryConditionalOrdersRequest{
+		Address:    acc1.GetAddress().String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Orders, 2)
+	require.Equal(t, exported.ConditionalOrders[:2], res.Orders)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	_, err = queryClient.ConditionalOrders(ctx2.Context(), &types.QueryConditionalOrdersRequest{Address: "foo"})
+	require.Error(t, err)
+}
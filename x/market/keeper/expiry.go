@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// ExpireTimedOrders removes all GoodTillTime orders whose expiry has been reached by the current block time, including
+// pending conditional orders.
+func (k *Keeper) ExpireTimedOrders(ctx sdk.Context) {
+	end := sdk.PrefixEndBytes(types.GetExpiryTimeKeyPrefix(ctx.BlockTime()))
+	k.expireOrders(ctx, types.GetExpiryTimePrefix(), end)
+}
+
+// ExpireBlockOrders removes all GoodTillBlock orders that cannot be matched after the current block, including pending
+// conditional orders.
+func (k *Keeper) ExpireBlockOrders(ctx sdk.Context) {
+	end := sdk.PrefixEndBytes(types.GetExpiryBlockKeyPrefix(ctx.BlockHeight()))
+	k.expireOrders(ctx, types.GetExpiryBlockPrefix(), end)
+}
+
+func (k *Keeper) expireOrders(ctx sdk.Context, start, end []byte) {
+	var (
+		store    = ctx.KVStore(k.key)
+		idxStore = ctx.KVStore(k.keyIndices)
+	)
+
+	// Collect the keys first, as the store cannot be modified while iterating.
+	var expiryKeys, ownerKeys [][]byte
+
+	it := idxStore.Iterator(start, end)
+	for ; it.Valid(); it.Next() {
+		expiryKeys = append(expiryKeys, it.Key())
+		ownerKeys = append(ownerKeys, it.Value())
+	}
+	it.Close()
+
+	for i, ownerKey := range ownerKeys {
+		bz := store.Get(ownerKey)
+		if bz == nil {
+			// Should not happen, as the index is maintained along with the order.
+			idxStore.Delete(expiryKeys[i])
+			continue
+		}
+
+		if bytes.HasPrefix(ownerKey, types.GetConditionalOrderPrefix()) {
+			co := new(types.ConditionalOrder)
+			k.cdc.MustUnmarshal(bz, co)
+
+			k.deleteConditionalOrder(ctx, co)
+			types.EmitConditionalExpireEvent(ctx, *co)
+			continue
+		}
+
+		order := new(types.Order)
+		k.cdc.MustUnmarshal(bz, order)
+
+		k.deleteOrder(ctx, order)
+		types.EmitExpireEvent(ctx, *order, types.ExpireReason_Expired)
+		k.recordOrder(ctx, *order, types.OrderStatus_Expired)
+	}
+}
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoodTillTimeExpiry(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	o1 := expiringOrder(t, ctx.BlockTime(), acc1, "100eur", "120usd", &expiry, 0)
+	o2 := expiringOrder(t, ctx.BlockTime(), acc1, "100eur", "130usd", timePtr(expiry.Add(time.Minute)), 0)
+	require.NoError(t, k.NewOrderSingle(ctx, o1))
+	require.NoError(t, k.NewOrderSingle(ctx, o2))
+
+	// Partially filled orders keep their expiry
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 2)
+
+	ctx = ctx.WithBlockTime(expiry.Add(-time.Nanosecond)).WithEventManager(sdk.NewEventManager())
+	k.ExpireTimedOrders(ctx)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 2)
+	require.False(t, findEventAttr(ctx, "expire"))
+
+	ctx = ctx.WithBlockTime(expiry)
+	k.ExpireTimedOrders(ctx)
+
+	orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 1)
+	require.Equal(t, o2.ClientOrderID, orders[0].ClientOrderID)
+
+	expired := filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "expire")
+	require.Len(t, expired, 1)
+	clientOrderID, _ := getEventAttrValue(expired[0], types.AttributeKeyClientOrderID)
+	require.Equal(t, o1.ClientOrderID, clientOrderID)
+	sourceFilled, _ := getEventAttrValue(expired[0], types.AttributeKeySourceFilled)
+	require.Equal(t, "50eur", sourceFilled)
+
+	// The expired order is no longer matched
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "500usd")
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "120usd", "100eur")))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc3.GetAddress()), 1)
+
+	ctx = ctx.WithBlockTime(expiry.Add(time.Hour))
+	k.ExpireTimedOrders(ctx)
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Empty(t, iteratorKeys(ctx, k, types.GetExpiryTimePrefix()))
+}
+
+func TestGoodTillBlockExpiry(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	o := expiringOrder(t, ctx.BlockTime(), acc1, "100eur", "120usd", nil, 11)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+
+	k.ExpireBlockOrders(ctx)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+
+	// Orders are good through their expiry block
+	ctx = ctx.WithBlockHeight(11)
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	k.ExpireBlockOrders(ctx)
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.True(t, findEventAttr(ctx, "expire"))
+	require.Empty(t, iteratorKeys(ctx, k, types.GetExpiryBlockPrefix()))
+}
+
+func TestExpiredOrderRejected(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+
+	o := expiringOrder(t, ctx.BlockTime(), acc1, "100eur", "120usd", timePtr(ctx.BlockTime()), 0)
+	require.ErrorIs(t, k.NewOrderSingle(ctx, o), types.ErrInvalidExpiry)
+
+	o = expiringOrder(t, ctx.BlockTime(), acc1, "100eur", "120usd", nil, 9)
+	require.ErrorIs(t, k.NewOrderSingle(ctx, o), types.ErrInvalidExpiry)
+
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+}
+
+func TestExpiryIndexCleanup(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	o := expiringOrder(t, ctx.BlockTime(), acc1, "100eur", "120usd", &expiry, 0)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+
+	// Replacing an order with a different time in force keeps the original expiry
+	replacement := order(ctx.BlockTime(), acc1, "200eur", "240usd")
+	require.NoError(t, k.CancelReplaceLimitOrder(ctx, replacement, o.ClientOrderID))
+
+	replaced := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), replacement.ClientOrderID)
+	require.Equal(t, types.TimeInForce_GoodTillTime, replaced.TimeInForce)
+	require.True(t, expiry.Equal(*replaced.GoodTillTime))
+	require.Len(t, iteratorKeys(ctx, k, types.GetExpiryTimePrefix()), 1)
+
+	// An expiring order can be given a new expiry
+	newExpiry := expiry.Add(time.Hour)
+	replacement2 := expiringOrder(t, ctx.BlockTime(), acc1, "200eur", "240usd", &newExpiry, 0)
+	require.NoError(t, k.CancelReplaceLimitOrder(ctx, replacement2, replacement.ClientOrderID))
+
+	replaced = k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), replacement2.ClientOrderID)
+	require.True(t, newExpiry.Equal(*replaced.GoodTillTime))
+	require.Equal(t, [][]byte{types.GetExpiryTimeKey(newExpiry, replaced.ID)}, iteratorKeys(ctx, k, types.GetExpiryTimePrefix()))
+
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), replacement2.ClientOrderID))
+	require.Empty(t, iteratorKeys(ctx, k, types.GetExpiryTimePrefix()))
+
+	// Orders removed by a balance change are removed from the index
+	o = expiringOrder(t, ctx.BlockTime(), acc1, "100eur", "120usd", &expiry, 0)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.NoError(t, bk.SendCoins(ctx, acc1.GetAddress(), randomAddress(), coins("5000eur")))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Empty(t, iteratorKeys(ctx, k, types.GetExpiryTimePrefix()))
+}
+
+func expiringOrder(t *testing.T, createdTm time.Time, account authtypes.AccountI, src, dst string, goodTillTime *time.Time, goodTillBlock int64) types.Order {
+	tif := types.TimeInForce_GoodTillBlock
+	if goodTillTime != nil {
+		tif = types.TimeInForce_GoodTillTime
+	}
+
+	o, err := types.NewOrderWithExpiry(createdTm, tif, coin(src), coin(dst), account.GetAddress(), cid(), goodTillTime, goodTillBlock)
+	require.NoError(t, err)
+	return o
+}
+
+func iteratorKeys(ctx sdk.Context, k *Keeper, prefix []byte) (keys [][]byte) {
+	it := sdk.KVStorePrefixIterator(ctx.KVStore(k.keyIndices), prefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+
+	return
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestConditionalOrderExpiry(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "100eur")))
+
+	expiry := ctx.BlockTime().Add(time.Hour)
+	timed := types.NewConditionalOrder(expiringOrder(t, ctx.BlockTime(), acc1, "100eur", "120usd", &expiry, 0), types.ConditionType_TakeProfit, sdk.MustNewDecFromStr("1.5"))
+	block := types.NewConditionalOrder(expiringOrder(t, ctx.BlockTime(), acc1, "100eur", "130usd", nil, 11), types.ConditionType_TakeProfit, sdk.MustNewDecFromStr("1.5"))
+	canceled := types.NewConditionalOrder(expiringOrder(t, ctx.BlockTime(), acc1, "100eur", "140usd", &expiry, 0), types.ConditionType_TakeProfit, sdk.MustNewDecFromStr("1.5"))
+	require.NoError(t, k.AddConditionalOrder(ctx, timed))
+	require.NoError(t, k.AddConditionalOrder(ctx, block))
+	require.NoError(t, k.AddConditionalOrder(ctx, canceled))
+
+	// Canceled conditional orders are removed from the expiry index
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), canceled.Order.ClientOrderID))
+	require.Len(t, iteratorKeys(ctx, k, types.GetExpiryTimePrefix()), 1)
+
+	ctx = ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	k.ExpireBlockOrders(ctx)
+
+	expired := filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "expire_conditional")
+	require.Len(t, expired, 1)
+	clientOrderID, _ := getEventAttrValue(expired[0], types.AttributeKeyClientOrderID)
+	require.Equal(t, block.Order.ClientOrderID, clientOrderID)
+	require.Nil(t, k.GetConditionalOrder(ctx, block.Order.Owner, block.Order.ClientOrderID))
+	require.Empty(t, iteratorKeys(ctx, k, types.GetExpiryBlockPrefix()))
+
+	ctx = ctx.WithBlockTime(expiry).WithEventManager(sdk.NewEventManager())
+	k.ExpireTimedOrders(ctx)
+
+	require.Len(t, filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "expire_conditional"), 1)
+	require.Empty(t, k.GetAllConditionalOrders(ctx))
+	require.Empty(t, iteratorKeys(ctx, k, types.GetExpiryTimePrefix()))
+	require.Empty(t, iteratorKeys(ctx, k, types.GetTriggerPrefix()))
+
+	// The orders in the book are unaffected
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 1)
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// InitGenesis restores the order book, conditional orders, market data, trading
+// halts, instrument configurations and order ID generator.
+// Bank genesis must be initialized beforehand, as every resting order must be
+// covered by its owner's spendable balance.
+func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	k.SetParams(ctx, gs.Params)
+
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	for i := range gs.MarketData {
+		md := gs.MarketData[i]
+		idxStore.Set(types.GetMarketDataKey(md.Source, md.Destination), k.cdc.MustMarshal(&md))
+	}
+
+	type ownerInstrument struct {
+		owner, source, destination string
+	}
+
+	// Track the demand per owner and instrument in the same way as NewOrderSingle to avoid phantom liquidity.
+	instrumentDemand := make(map[ownerInstrument]sdk.Int)
+
+	for i := range gs.Orders {
+		order := gs.Orders[i]
+
+		owner, err := sdk.AccAddressFromBech32(order.Owner)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "order %d owner: %v", order.ID, order.Owner)
+		}
+
+		demandKey := ownerInstrument{order.Owner, order.Source.Denom, order.Destination.Denom}
+		demand, found := instrumentDemand[demandKey]
+		if !found {
+			demand = sdk.ZeroInt()
+		}
+		demand = demand.Add(order.SourceRemaining)
+		instrumentDemand[demandKey] = demand
+
+		spendable := k.bk.SpendableCoins(ctx, owner).AmountOf(order.Source.Denom)
+		if demand.GT(spendable) {
+			return sdkerrors.Wrapf(
+				types.ErrAccountBalanceInsufficientForInstrument,
+				"order %d: account %v has %v%v spendable, but orders in instrument %v/%v require %v%v",
+				order.ID, order.Owner, spendable, order.Source.Denom, order.Source.Denom, order.Destination.Denom, demand, order.Source.Denom,
+			)
+		}
+
+		if k.GetOrderByOwnerAndClientOrderId(ctx, order.Owner, order.ClientOrderID) != nil {
+			return sdkerrors.Wrapf(types.ErrNonUniqueClientOrderId, "order %d: %v", order.ID, order.ClientOrderID)
+		}
+
+		k.registerMarketData(ctx, order.Source.Denom, order.Destination.Denom)
+		k.registerMarketData(ctx, order.Destination.Denom, order.Source.Denom)
+		k.setOrder(ctx, &order)
+	}
+
+	for i := range gs.ConditionalOrders {
+		co := gs.ConditionalOrders[i]
+
+		if k.GetOrderByOwnerAndClientOrderId(ctx, co.Order.Owner, co.Order.ClientOrderID) != nil ||
+			k.GetConditionalOrder(ctx, co.Order.Owner, co.Order.ClientOrderID) != nil {
+			return sdkerrors.Wrapf(types.ErrNonUniqueClientOrderId, "conditional order %d: %v", co.Order.ID, co.Order.ClientOrderID)
+		}
+
+		k.setConditionalOrder(ctx, &co)
+	}
+
+	for _, halt := range gs.TradingHalts {
+		k.setTradingHalt(ctx, halt)
+	}
+
+	for _, config := range gs.InstrumentConfigs {
+		k.setInstrumentConfig(ctx, config)
+	}
+
+	k.setNextOrderNumber(ctx, gs.NextOrderID)
+	return nil
+}
+
+// ExportGenesis returns the resting and conditional orders, market data, trading
+// halts, instrument configurations, the next order ID and the parameters. The trade history and the state
+// of the price bands are not exported.
+func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	orders := make([]types.Order, 0)
+	for _, order := range k.GetAllOrders(ctx) {
+		orders = append(orders, *order)
+	}
+
+	marketData := k.GetInstruments(ctx)
+	if marketData == nil {
+		marketData = make([]types.MarketData, 0)
+	}
+
+	gs := types.NewGenesisState(orders, marketData, k.peekNextOrderNumber(ctx), k.GetParams(ctx))
+	gs.ConditionalOrders = k.GetAllConditionalOrders(ctx)
+	gs.TradingHalts = k.GetTradingHalts(ctx)
+	gs.InstrumentConfigs = k.GetInstrumentConfigs(ctx)
+	return &gs
+}
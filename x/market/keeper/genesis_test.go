@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisExportImport(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "7400usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "500eur", "1000usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "300usd", "500eur")))
+	require.NoError(t, k.HaltTrading(ctx, "chf", "jpy"))
+	require.NoError(t, k.SetInstrumentConfig(ctx, "eur", "usd", sdk.NewDecWithPrec(1, 2), sdk.NewInt(10), sdk.NewInt(20)))
+
+	exported := k.ExportGenesis(ctx)
+	require.NoError(t, types.ValidateGenesis(*exported))
+	require.Len(t, exported.Orders, 3)
+	require.Len(t, exported.MarketData, 2)
+	require.Equal(t, uint64(4), exported.NextOrderID)
+	require.Len(t, exported.TradingHalts, 1)
+	require.Len(t, exported.InstrumentConfigs, 1)
+
+	// Import the state into a fresh chain with the same balances.
+	ctx2, k2, ak2, bk2 := createTestComponents(t)
+	createAccount(ctx2, ak2, bk2, acc1.GetAddress(), bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	createAccount(ctx2, ak2, bk2, acc2.GetAddress(), bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+
+	require.NoError(t, k2.InitGenesis(ctx2, *exported))
+	require.Equal(t, exported, k2.ExportGenesis(ctx2))
+
+	require.Equal(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), k2.GetOrdersByOwner(ctx2, acc1.GetAddress()))
+	require.Equal(t, k.GetBestPrice(ctx, "eur", "usd"), k2.GetBestPrice(ctx2, "eur", "usd"))
+
+	md := k2.GetInstrument(ctx2, "eur", "usd")
+	require.NotNil(t, md)
+	require.NotNil(t, md.LastPrice)
+	require.Equal(t, k.GetInstrument(ctx, "eur", "usd").LastPrice, md.LastPrice)
+
+	require.NotNil(t, k2.GetTradingHalt(ctx2, "jpy", "chf"))
+	require.Equal(t, k.GetInstrumentConfig(ctx, "eur", "usd"), k2.GetInstrumentConfig(ctx2, "eur", "usd"))
+
+	// Order IDs continue where the exported chain left off.
+	require.Equal(t, uint64(4), k2.getNextOrderNumber(ctx2))
+
+	// The imported orders are matched like any other resting order.
+	acc3 := createAccount(ctx2, ak2, bk2, randomAddress(), "10000usd")
+	require.NoError(t, k2.NewOrderSingle(ctx2, order(ctx2.BlockTime(), acc3, "10000usd", "100eur")))
+	require.Equal(t, "100", bk2.GetAllBalances(ctx2, acc3.GetAddress()).AmountOf("eur").String())
+}
+
+func TestGenesisInsufficientBalance(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "4000eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1000eur", "100chf")))
+
+	exported := k.ExportGenesis(ctx)
+	require.NoError(t, types.ValidateGenesis(*exported))
+
+	// Orders in different instruments may be based on the same balance.
+	ctx2, k2, ak2, bk2 := createTestComponents(t)
+	createAccount(ctx2, ak2, bk2, acc1.GetAddress(), "4000eur")
+	require.NoError(t, k2.InitGenesis(ctx2, *exported))
+
+	// Orders are not allowed to exceed the spendable balance.
+	ctx3, k3, ak3, bk3 := createTestComponents(t)
+	createAccount(ctx3, ak3, bk3, acc1.GetAddress(), "3999eur")
+	err := k3.InitGenesis(ctx3, *exported)
+	require.ErrorIs(t, err, types.ErrAccountBalanceInsufficientForInstrument)
+
+	// Multiple orders in the same instrument must be covered by the balance combined.
+	for i := range exported.Orders {
+		exported.Orders[i].Destination = sdk.NewCoin("usd", sdk.NewInt(100))
+	}
+	ctx4, k4, ak4, bk4 := createTestComponents(t)
+	createAccount(ctx4, ak4, bk4, acc1.GetAddress(), "4000eur")
+	err = k4.InitGenesis(ctx4, *exported)
+	require.ErrorIs(t, err, types.ErrAccountBalanceInsufficientForInstrument)
+}
+
+func TestGenesisJSONRoundTripEscapesClientOrderIDs(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	o := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	o.ClientOrderID = `quoted "id" \ with backslash`
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+
+	co := types.NewConditionalOrder(order(ctx.BlockTime(), acc2, "100usd", "50eur"), types.ConditionType_StopLoss, sdk.NewDec(3))
+	co.Order.ClientOrderID = `a"b\c`
+	require.NoError(t, k.AddConditionalOrder(ctx, co))
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	exported := k.ExportGenesis(ctx)
+	bz, err := cdc.MarshalJSON(exported)
+	require.NoError(t, err)
+
+	var imported types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &imported))
+	require.NoError(t, types.ValidateGenesis(imported))
+
+	ctx2, k2, ak2, bk2 := createTestComponents(t)
+	createAccount(ctx2, ak2, bk2, acc1.GetAddress(), "5000eur")
+	createAccount(ctx2, ak2, bk2, acc2.GetAddress(), "5000usd")
+	require.NoError(t, k2.InitGenesis(ctx2, imported))
+
+	require.NotNil(t, k2.GetOrderByOwnerAndClientOrderId(ctx2, acc1.GetAddress().String(), o.ClientOrderID))
+	require.NotNil(t, k2.GetConditionalOrder(ctx2, acc2.GetAddress().String(), co.Order.ClientOrderID))
+	require.Equal(t, exported, k2.ExportGenesis(ctx2))
+}
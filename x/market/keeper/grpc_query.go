@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/e-money/em-ledger/x/market/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -24,8 +27,48 @@ func (k Keeper) ByAccount(c context.Context, req *types.QueryByAccountRequest) (
 		return nil, sdkerrors.ErrInvalidAddress
 	}
 
-	orders := k.GetOrdersByOwner(ctx, account)
-	return &types.QueryByAccountResponse{Orders: orders}, nil
+	if (req.Source != "" && sdk.ValidateDenom(req.Source) != nil) || (req.Destination != "" && sdk.ValidateDenom(req.Destination) != nil) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid denoms: %v %v", req.Source, req.Destination)
+	}
+
+	matches := func(order *types.Order) bool {
+		switch {
+		case req.Source != "" && order.Source.Denom != req.Source:
+			return false
+		case req.Destination != "" && order.Destination.Denom != req.Destination:
+			return false
+		case req.CreatedFrom != nil && order.Created.Before(*req.CreatedFrom):
+			return false
+		case req.CreatedTo != nil && !order.Created.Before(*req.CreatedTo):
+			return false
+		}
+		return true
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.GetOwnerKey(account.String(), ""))
+
+	orders := make([]*types.Order, 0)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		order := new(types.Order)
+		if err := k.cdc.Unmarshal(value, order); err != nil {
+			return false, err
+		}
+
+		if !matches(order) {
+			return false, nil
+		}
+
+		if accumulate {
+			redacted := order.Redacted()
+			orders = append(orders, &redacted)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryByAccountResponse{Orders: orders, Pagination: pageRes}, nil
 }
 
 func (k Keeper) Instruments(c context.Context, req *types.QueryInstrumentsRequest) (*types.QueryInstrumentsResponse, error) {
@@ -51,6 +94,37 @@ func (k Keeper) Instrument(c context.Context, req *types.QueryInstrumentRequest)
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid denoms: %v %v", source, destination)
 	}
 
+	// All orders are returned if no limit is set.
+	limit := req.Limit
+	if limit > types.MaxInstrumentOrders {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Number of orders cannot exceed %v", types.MaxInstrumentOrders)
+	}
+
+	res := &types.QueryInstrumentResponse{
+		Source:      source,
+		Destination: destination,
+		Orders:      make([]types.QueryOrderResponse, 0),
+		Bids:        make([]types.QueryOrderResponse, 0),
+	}
+
+	switch req.Side {
+	case types.OrderSide_Asks:
+		res.Orders = k.getInstrumentOrders(ctx, source, destination, int(limit))
+	case types.OrderSide_Bids:
+		res.Bids = k.getInstrumentOrders(ctx, destination, source, int(limit))
+	case types.OrderSide_Both:
+		res.Orders = k.getInstrumentOrders(ctx, source, destination, int(limit))
+		res.Bids = k.getInstrumentOrders(ctx, destination, source, int(limit))
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Unknown side: %v", req.Side)
+	}
+
+	return res, nil
+}
+
+// getInstrumentOrders returns up to limit orders selling source for destination, best price first. A limit of zero
+// returns all orders.
+func (k Keeper) getInstrumentOrders(ctx sdk.Context, source, destination string, limit int) []types.QueryOrderResponse {
 	orders := make([]types.QueryOrderResponse, 0)
 
 	idxStore := ctx.KVStore(k.keyIndices)
@@ -59,28 +133,196 @@ func (k Keeper) Instrument(c context.Context, req *types.QueryInstrumentRequest)
 	it := sdk.KVStorePrefixIterator(idxStore, key)
 	defer it.Close()
 
-	for it.Valid() {
+	for ; it.Valid() && (limit == 0 || len(orders) < limit); it.Next() {
 		order := new(types.Order)
 		k.cdc.MustUnmarshal(it.Value(), order)
 
 		orders = append(orders, types.QueryOrderResponse{
 			ID:              order.ID,
 			Owner:           order.Owner,
-			SourceRemaining: order.SourceRemaining.String(),
+			SourceRemaining: order.VisibleRemaining().String(),
 			Price:           order.Price(),
 			Created:         order.Created,
 		})
+	}
+
+	return orders
+}
+
+func (k Keeper) Depth(c context.Context, req *types.QueryDepthRequest) (*types.QueryDepthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return queryDepth(ctx, &k, req.Source, req.Destination, req.Levels)
+}
 
-		it.Next()
+func queryDepth(ctx sdk.Context, k *Keeper, source, destination string, levels uint32) (*types.QueryDepthResponse, error) {
+	if sdk.ValidateDenom(source) != nil || sdk.ValidateDenom(destination) != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid denoms: %v %v", source, destination)
 	}
 
-	return &types.QueryInstrumentResponse{
+	if levels == 0 {
+		levels = types.DefaultDepthLevels
+	}
+
+	if levels > types.MaxDepthLevels {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Number of levels cannot exceed %v", types.MaxDepthLevels)
+	}
+
+	return &types.QueryDepthResponse{
 		Source:      source,
 		Destination: destination,
-		Orders:      orders,
+		Asks:        k.GetDepth(ctx, source, destination, int(levels)),
+		Bids:        k.GetDepth(ctx, destination, source, int(levels)),
 	}, nil
 }
 
+func (k Keeper) Trades(c context.Context, req *types.QueryTradesRequest) (*types.QueryTradesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if sdk.ValidateDenom(req.Source) != nil || sdk.ValidateDenom(req.Destination) != nil || req.Source == req.Destination {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid denoms: %v %v", req.Source, req.Destination)
+	}
+
+	trades, pageRes, err := k.GetTrades(ctx, req.Source, req.Destination, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryTradesResponse{Trades: trades, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Candles(c context.Context, req *types.QueryCandlesRequest) (*types.QueryCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if sdk.ValidateDenom(req.Source) != nil || sdk.ValidateDenom(req.Destination) != nil || req.Source == req.Destination {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid denoms: %v %v", req.Source, req.Destination)
+	}
+
+	if req.Interval < time.Second || req.Interval%time.Second != 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Invalid candle interval: %v", req.Interval)
+	}
+
+	candles, pageRes, err := k.GetCandles(ctx, req.Source, req.Destination, req.Interval, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryCandlesResponse{Candles: candles, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ConditionalOrders(c context.Context, req *types.QueryConditionalOrdersRequest) (*types.QueryConditionalOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress
+	}
+
+	orders, pageRes, err := k.GetConditionalOrdersByOwner(ctx, account, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryConditionalOrdersResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Order(c context.Context, req *types.QueryOrderByIdRequest) (*types.QueryOrderByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	order := k.GetOrderByID(ctx, req.Id)
+	if order == nil {
+		return nil, status.Errorf(codes.NotFound, "order %d not found", req.Id)
+	}
+
+	return &types.QueryOrderByIdResponse{Order: order.Redacted()}, nil
+}
+
+func (k Keeper) OrderByClientId(c context.Context, req *types.QueryOrderByClientIdRequest) (*types.QueryOrderByClientIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress
+	}
+
+	order := k.GetOrderByOwnerAndClientOrderId(ctx, req.Address, req.ClientOrderId)
+	if order == nil {
+		return nil, status.Errorf(codes.NotFound, "order %v of %v not found", req.ClientOrderId, req.Address)
+	}
+
+	return &types.QueryOrderByClientIdResponse{Order: order.Redacted()}, nil
+}
+
+func (k Keeper) OrderHistory(c context.Context, req *types.QueryOrderHistoryRequest) (*types.QueryOrderHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	account, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress
+	}
+
+	orders, pageRes, err := k.GetOrderHistory(ctx, account, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryOrderHistoryResponse{Orders: orders, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Quote(c context.Context, req *types.QueryQuoteRequest) (*types.QueryQuoteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress
+	}
+
+	timeInForce := req.TimeInForce
+	if timeInForce == types.TimeInForce_Unspecified {
+		timeInForce = types.TimeInForce_GoodTillCancel
+	}
+
+	order, err := types.NewOrder(ctx.BlockTime(), timeInForce, req.Source, req.Destination, owner, "quote")
+	if err != nil {
+		return nil, err
+	}
+	order.SelfTradePrevention = req.SelfTradePrevention
+
+	return k.quoteOrder(ctx, order)
+}
+
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
 func queryInstruments(ctx sdk.Context, k *Keeper) (*types.QueryInstrumentsResponse, error) {
 	instruments, err := k.GetAllInstruments(ctx)
 	if err != nil {
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/json"
+	"fmt"
 	"math"
 	"testing"
 	"time"
@@ -10,8 +12,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func TestQueryByAccount(t *testing.T) {
@@ -84,6 +88,258 @@ func TestQueryByAccount(t *testing.T) {
 	}
 }
 
+func TestQueryByAccountFilters(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	acc := createAccount(ctx, ak, bk, randomAddress(), "1000eur,1000usd")
+	createAccount(ctx, ak, bk, randomAddress(), "1chf")
+	t0 := ctx.BlockTime()
+
+	var clientOrderIDs []string
+	for i, o := range []types.Order{
+		order(t0, acc, "100eur", "120usd"),
+		order(t0.Add(time.Minute), acc, "100eur", "120chf"),
+		order(t0.Add(2*time.Minute), acc, "100usd", "120chf"),
+		order(t0.Add(3*time.Minute), acc, "100eur", "130usd"),
+	} {
+		o.ClientOrderID = fmt.Sprintf("order-%d", i)
+		clientOrderIDs = append(clientOrderIDs, o.ClientOrderID)
+		require.NoError(t, k.NewOrderSingle(ctx, o))
+	}
+
+	t1, t3 := t0.Add(time.Minute), t0.Add(3*time.Minute)
+	specs := map[string]struct {
+		req    *types.QueryByAccountRequest
+		expErr bool
+		exp    []string
+	}{
+		"all": {
+			req: &types.QueryByAccountRequest{},
+			exp: clientOrderIDs,
+		},
+		"source": {
+			req: &types.QueryByAccountRequest{Source: "eur"},
+			exp: []string{"order-0", "order-1", "order-3"},
+		},
+		"destination": {
+			req: &types.QueryByAccountRequest{Destination: "chf"},
+			exp: []string{"order-1", "order-2"},
+		},
+		"instrument": {
+			req: &types.QueryByAccountRequest{Source: "eur", Destination: "usd"},
+			exp: []string{"order-0", "order-3"},
+		},
+		"created range": {
+			req: &types.QueryByAccountRequest{CreatedFrom: &t1, CreatedTo: &t3},
+			exp: []string{"order-1", "order-2"},
+		},
+		"filtered page": {
+			req: &types.QueryByAccountRequest{Source: "eur", Pagination: &query.PageRequest{Limit: 2, CountTotal: true}},
+			exp: []string{"order-0", "order-1"},
+		},
+		"filtered page offset": {
+			req: &types.QueryByAccountRequest{Source: "eur", Pagination: &query.PageRequest{Offset: 2}},
+			exp: []string{"order-3"},
+		},
+		"invalid denomination": {
+			req:    &types.QueryByAccountRequest{Source: "#!@@"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			spec.req.Address = acc.GetAddress().String()
+			gotRsp, gotErr := queryClient.ByAccount(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			var got []string
+			for _, o := range gotRsp.Orders {
+				got = append(got, o.ClientOrderID)
+			}
+			assert.Equal(t, spec.exp, got)
+		})
+	}
+
+	res, err := queryClient.ByAccount(sdk.WrapSDKContext(ctx), &types.QueryByAccountRequest{
+		Address:    acc.GetAddress().String(),
+		Source:     "eur",
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = queryClient.ByAccount(sdk.WrapSDKContext(ctx), &types.QueryByAccountRequest{
+		Address:    acc.GetAddress().String(),
+		Source:     "eur",
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Orders, 1)
+	require.Equal(t, "order-3", res.Orders[0].ClientOrderID)
+}
+
+func TestQueryOrder(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	o1 := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	o2 := order(ctx.BlockTime(), acc1, "200eur", "260usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o1))
+	require.NoError(t, k.NewOrderSingle(ctx, o2))
+
+	active := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), o1.ClientOrderID)
+	require.NotNil(t, active)
+
+	res, err := queryClient.Order(ctx.Context(), &types.QueryOrderByIdRequest{Id: active.ID})
+	require.NoError(t, err)
+	require.Equal(t, *active, res.Order)
+
+	resByCid, err := queryClient.OrderByClientId(ctx.Context(), &types.QueryOrderByClientIdRequest{
+		Address:       acc1.GetAddress().String(),
+		ClientOrderId: o1.ClientOrderID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, *active, resByCid.Order)
+
+	_, err = queryClient.Order(ctx.Context(), &types.QueryOrderByIdRequest{Id: 1000})
+	require.Error(t, err)
+
+	_, err = queryClient.OrderByClientId(ctx.Context(), &types.QueryOrderByClientIdRequest{
+		Address:       acc2.GetAddress().String(),
+		ClientOrderId: o1.ClientOrderID,
+	})
+	require.Error(t, err)
+
+	_, err = queryClient.OrderByClientId(ctx.Context(), &types.QueryOrderByClientIdRequest{
+		Address:       "foo",
+		ClientOrderId: o1.ClientOrderID,
+	})
+	require.Error(t, err)
+
+	// Filled and canceled orders are no longer found
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+	_, err = queryClient.Order(ctx.Context(), &types.QueryOrderByIdRequest{Id: active.ID})
+	require.Error(t, err)
+
+	canceled := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), o2.ClientOrderID)
+	require.NotNil(t, canceled)
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), o2.ClientOrderID))
+	require.Nil(t, k.GetOrderByID(ctx, canceled.ID))
+
+	_, err = queryClient.OrderByClientId(ctx.Context(), &types.QueryOrderByClientIdRequest{
+		Address:       acc1.GetAddress().String(),
+		ClientOrderId: o2.ClientOrderID,
+	})
+	require.Error(t, err)
+}
+
+func TestQueryIcebergOrderRedacted(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	iceberg := order(ctx.BlockTime(), acc1, "1000eur", "1200usd")
+	displaySize := sdk.NewInt(100)
+	iceberg.DisplaySize = &displaySize
+	require.NoError(t, k.NewOrderSingle(ctx, iceberg))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+
+	active := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), iceberg.ClientOrderID)
+	require.NotNil(t, active)
+	require.Equal(t, sdk.NewInt(950), active.SourceRemaining)
+
+	res, err := queryClient.Order(ctx.Context(), &types.QueryOrderByIdRequest{Id: active.ID})
+	require.NoError(t, err)
+	resByCid, err := queryClient.OrderByClientId(ctx.Context(), &types.QueryOrderByClientIdRequest{
+		Address:       acc1.GetAddress().String(),
+		ClientOrderId: iceberg.ClientOrderID,
+	})
+	require.NoError(t, err)
+
+	// Only the displayed slice is revealed
+	for _, o := range []types.Order{res.Order, resByCid.Order} {
+		require.Equal(t, sdk.NewInt(50), o.SourceRemaining)
+		require.Equal(t, coin("100eur"), o.Source)
+		require.Equal(t, coin("120usd"), o.Destination)
+		require.Equal(t, active.Price(), o.Price())
+		require.Nil(t, o.DisplaySize)
+		require.Nil(t, o.DisplayRemaining)
+	}
+
+	// The orders of an account are redacted in both the gRPC and the legacy query
+	resByAccount, err := queryClient.ByAccount(ctx.Context(), &types.QueryByAccountRequest{Address: acc1.GetAddress().String()})
+	require.NoError(t, err)
+	require.Len(t, resByAccount.Orders, 1)
+
+	bz, err := NewQuerier(k)(ctx, []string{types.QueryByAccount, acc1.GetAddress().String()}, abci.RequestQuery{})
+	require.NoError(t, err)
+	var legacyRes types.QueryByAccountResponse
+	require.NoError(t, json.Unmarshal(bz, &legacyRes))
+	require.Len(t, legacyRes.Orders, 1)
+
+	for _, o := range []*types.Order{resByAccount.Orders[0], legacyRes.Orders[0]} {
+		require.Equal(t, sdk.NewInt(50), o.SourceRemaining)
+		require.Equal(t, coin("100eur"), o.Source)
+		require.Equal(t, coin("120usd"), o.Destination)
+		require.Nil(t, o.DisplaySize)
+		require.Nil(t, o.DisplayRemaining)
+	}
+
+	// The accept event only shows the display size of the order
+	acceptEvents := filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "accept")
+	require.NotEmpty(t, acceptEvents)
+	src, _ := getEventAttrValue(acceptEvents[0], types.AttributeKeySource)
+	require.Equal(t, "100eur", src)
+	dst, _ := getEventAttrValue(acceptEvents[0], types.AttributeKeyDestination)
+	require.Equal(t, "120usd", dst)
+
+	var accepted *types.EventOrderAccepted
+	for _, ev := range ctx.EventManager().ABCIEvents() {
+		if ev.Type == proto.MessageName(&types.EventOrderAccepted{}) {
+			msg, err := sdk.ParseTypedEvent(ev)
+			require.NoError(t, err)
+			accepted = msg.(*types.EventOrderAccepted)
+			break
+		}
+	}
+	require.NotNil(t, accepted)
+	require.Equal(t, iceberg.ClientOrderID, accepted.ClientOrderID)
+	require.Equal(t, coin("100eur"), accepted.Source)
+	require.Equal(t, coin("120usd"), accepted.Destination)
+
+	// The order history records the order as displayed when it was completed
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), iceberg.ClientOrderID))
+	resHistory, err := queryClient.OrderHistory(ctx.Context(), &types.QueryOrderHistoryRequest{Address: acc1.GetAddress().String()})
+	require.NoError(t, err)
+	require.Len(t, resHistory.Orders, 1)
+	require.Equal(t, coin("100eur"), resHistory.Orders[0].Source)
+	require.Equal(t, coin("120usd"), resHistory.Orders[0].Destination)
+	require.Equal(t, sdk.NewInt(50), resHistory.Orders[0].SourceFilled)
+}
+
 func TestInstruments(t *testing.T) {
 	enc := MakeTestEncodingConfig()
 	ctx, k, _, bk := createTestComponentsWithEncoding(t, enc)
@@ -196,6 +452,59 @@ func TestInstrument(t *testing.T) {
 	}
 }
 
+func TestInstrumentSideAndLimit(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "1000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "1000usd")
+
+	for _, dst := range []string{"130usd", "110usd", "120usd"} {
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", dst)))
+	}
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "100eur")))
+
+	prices := func(orders []types.QueryOrderResponse) (res []string) {
+		for _, o := range orders {
+			res = append(res, o.Price.String())
+		}
+		return
+	}
+
+	res, err := queryClient.Instrument(sdk.WrapSDKContext(ctx), &types.QueryInstrumentRequest{Source: "eur", Destination: "usd", Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1.100000000000000000", "1.200000000000000000"}, prices(res.Orders))
+	require.Empty(t, res.Bids)
+
+	res, err = queryClient.Instrument(sdk.WrapSDKContext(ctx), &types.QueryInstrumentRequest{Source: "eur", Destination: "usd", Side: types.OrderSide_Bids})
+	require.NoError(t, err)
+	require.Empty(t, res.Orders)
+	require.Equal(t, []string{"1.000000000000000000"}, prices(res.Bids))
+
+	res, err = queryClient.Instrument(sdk.WrapSDKContext(ctx), &types.QueryInstrumentRequest{Source: "eur", Destination: "usd", Side: types.OrderSide_Both})
+	require.NoError(t, err)
+	require.Len(t, res.Orders, 3)
+	require.Len(t, res.Bids, 1)
+
+	_, err = queryClient.Instrument(sdk.WrapSDKContext(ctx), &types.QueryInstrumentRequest{Source: "eur", Destination: "usd", Limit: types.MaxInstrumentOrders + 1})
+	require.Error(t, err)
+
+	// Without a limit, all orders are returned
+	for i := 0; i < 150; i++ {
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1eur", "2usd")))
+	}
+	res, err = queryClient.Instrument(sdk.WrapSDKContext(ctx), &types.QueryInstrumentRequest{Source: "eur", Destination: "usd"})
+	require.NoError(t, err)
+	require.Len(t, res.Orders, 153)
+
+	_, err = queryClient.Instrument(sdk.WrapSDKContext(ctx), &types.QueryInstrumentRequest{Source: "eur", Destination: "usd", Side: 7})
+	require.Error(t, err)
+}
+
 func getTotalSupply(t *testing.T, ctx sdk.Context, bk bankkeeper.Keeper) sdk.Coins {
 	totalSupply, _, err := bk.GetPaginatedTotalSupply(
 		ctx, &query.PageRequest{Limit: math.MaxUint64},
@@ -242,3 +551,233 @@ func fundAccount(ctx sdk.Context, acc sdk.AccAddress, bk bankkeeper.Keeper,
 
 	return setAccBalance(ctx, acc, bk, balance)
 }
+
+func TestDepth(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+
+	for _, o := range []types.Order{
+		order(ctx.BlockTime(), acc1, "100eur", "120usd"),
+		order(ctx.BlockTime(), acc3, "200eur", "240usd"),
+		order(ctx.BlockTime(), acc1, "100eur", "130usd"),
+		order(ctx.BlockTime(), acc1, "100eur", "140usd"),
+		order(ctx.BlockTime(), acc2, "100usd", "100eur"),
+		order(ctx.BlockTime(), acc2, "110usd", "100eur"),
+	} {
+		require.NoError(t, k.NewOrderSingle(ctx, o))
+	}
+
+	level := func(price string, remaining int64, count uint32) types.PriceLevel {
+		return types.PriceLevel{Price: sdk.MustNewDecFromStr(price), SourceRemaining: sdk.NewInt(remaining), OrderCount: count}
+	}
+
+	specs := map[string]struct {
+		req     *types.QueryDepthRequest
+		expErr  bool
+		expAsks []types.PriceLevel
+		expBids []types.PriceLevel
+	}{
+		"all levels": {
+			req:     &types.QueryDepthRequest{Source: "eur", Destination: "usd"},
+			expAsks: []types.PriceLevel{level("1.2", 300, 2), level("1.3", 100, 1), level("1.4", 100, 1)},
+			expBids: []types.PriceLevel{level("0.909090909090909091", 110, 1), level("1", 100, 1)},
+		},
+		"limited levels": {
+			req:     &types.QueryDepthRequest{Source: "eur", Destination: "usd", Levels: 1},
+			expAsks: []types.PriceLevel{level("1.2", 300, 2)},
+			expBids: []types.PriceLevel{level("0.909090909090909091", 110, 1)},
+		},
+		"reversed": {
+			req:     &types.QueryDepthRequest{Source: "usd", Destination: "eur", Levels: 2},
+			expAsks: []types.PriceLevel{level("0.909090909090909091", 110, 1), level("1", 100, 1)},
+			expBids: []types.PriceLevel{level("1.2", 300, 2), level("1.3", 100, 1)},
+		},
+		"empty book": {
+			req: &types.QueryDepthRequest{Source: "eur", Destination: "chf"},
+		},
+		"too many levels": {
+			req:    &types.QueryDepthRequest{Source: "eur", Destination: "usd", Levels: types.MaxDepthLevels + 1},
+			expErr: true,
+		},
+		"invalid denom": {
+			req:    &types.QueryDepthRequest{Source: "e", Destination: "usd"},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotRsp, gotErr := queryClient.Depth(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expAsks, gotRsp.Asks)
+			assert.Equal(t, spec.expBids, gotRsp.Bids)
+		})
+	}
+
+	_, err := k.Depth(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
+
+func TestTradesAndCandles(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "10000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "10000usd")
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+	}
+
+	trades, err := queryClient.Trades(ctx.Context(), &types.QueryTradesRequest{
+		Source:      "usd",
+		Destination: "eur",
+		Pagination:  &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, trades.Trades, 2)
+	require.NotNil(t, trades.Pagination.NextKey)
+	require.Equal(t, coin("120usd"), trades.Trades[0].Source)
+
+	trades, err = queryClient.Trades(ctx.Context(), &types.QueryTradesRequest{
+		Source:      "usd",
+		Destination: "eur",
+		Pagination:  &query.PageRequest{Key: trades.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, trades.Trades, 1)
+	require.Equal(t, uint64(2), trades.Trades[0].ID)
+
+	candles, err := queryClient.Candles(ctx.Context(), &types.QueryCandlesRequest{Source: "eur", Destination: "usd", Interval: time.Hour})
+	require.NoError(t, err)
+	require.Len(t, candles.Candles, 1)
+	require.Equal(t, sdk.NewInt(300), candles.Candles[0].Volume)
+
+	_, err = queryClient.Trades(ctx.Context(), &types.QueryTradesRequest{Source: "eur", Destination: "eur"})
+	require.Error(t, err)
+
+	_, err = queryClient.Candles(ctx.Context(), &types.QueryCandlesRequest{Source: "eur", Destination: "usd", Interval: time.Millisecond})
+	require.Error(t, err)
+
+	params, err := queryClient.Params(ctx.Context(), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params.Params)
+}
+
+func TestQuote(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "5000chf")
+	acc4 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+
+	// usd -> eur is available directly at 1.2 and synthetically through chf at 1
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "100chf", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc4, "100eur", "100chf")))
+
+	quote, err := queryClient.Quote(ctx.Context(), &types.QueryQuoteRequest{
+		Owner:       acc2.GetAddress().String(),
+		Source:      coin("200usd"),
+		Destination: coin("150eur"),
+		TimeInForce: types.TimeInForce_ImmediateOrCancel,
+	})
+	require.NoError(t, err)
+	require.True(t, quote.Success)
+	require.True(t, quote.Synthetic)
+	require.False(t, quote.Resting)
+	require.Len(t, quote.Fills, 3)
+
+	legs := make(map[string]types.QuoteLeg)
+	for _, leg := range quote.Legs {
+		legs[leg.Source+"/"+leg.Destination] = leg
+	}
+	require.Len(t, legs, 3)
+	require.Equal(t, types.QuoteLeg{Source: "usd", Destination: "eur", SourceFilled: sdk.NewInt(120), DestinationFilled: sdk.NewInt(100)}, legs["usd/eur"])
+	require.Equal(t, types.QuoteLeg{Source: "usd", Destination: "chf", SourceFilled: sdk.NewInt(50), DestinationFilled: sdk.NewInt(50)}, legs["usd/chf"])
+	require.Equal(t, types.QuoteLeg{Source: "chf", Destination: "eur", SourceFilled: sdk.NewInt(50), DestinationFilled: sdk.NewInt(50)}, legs["chf/eur"])
+	require.Equal(t, sdk.NewInt(170), quote.SourceFilled)
+	require.Equal(t, sdk.NewInt(150), quote.DestinationFilled)
+	require.Equal(t, quote.DestinationFilled.ToDec().Quo(quote.SourceFilled.ToDec()), quote.AveragePrice)
+
+	// Nothing was executed
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc3.GetAddress()), 1)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc4.GetAddress()), 1)
+	require.Equal(t, "5000usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+
+	// The quote matches the execution of the order
+	ioc := order(ctx.BlockTime(), acc2, "200usd", "150eur")
+	ioc.TimeInForce = types.TimeInForce_ImmediateOrCancel
+	require.NoError(t, k.NewOrderSingle(ctx, ioc))
+
+	balance := bk.GetAllBalances(ctx, acc2.GetAddress())
+	require.Equal(t, sdk.NewInt(5000).Sub(quote.SourceFilled), balance.AmountOf("usd"))
+	require.Equal(t, quote.DestinationFilled.Sub(quote.Fee), balance.AmountOf("eur"))
+
+	// A fill-or-kill order exceeding the book would be killed
+	quote, err = queryClient.Quote(ctx.Context(), &types.QueryQuoteRequest{
+		Owner:       acc2.GetAddress().String(),
+		Source:      coin("1000usd"),
+		Destination: coin("500eur"),
+		TimeInForce: types.TimeInForce_FillOrKill,
+	})
+	require.NoError(t, err)
+	require.False(t, quote.Success)
+	require.Equal(t, types.ExpireReason_Killed, quote.ExpireReason)
+	require.Empty(t, quote.Fills)
+	require.True(t, quote.SourceFilled.IsZero())
+
+	// Orders that do not match are placed in the book
+	quote, err = queryClient.Quote(ctx.Context(), &types.QueryQuoteRequest{
+		Owner:       acc2.GetAddress().String(),
+		Source:      coin("10usd"),
+		Destination: coin("100eur"),
+	})
+	require.NoError(t, err)
+	require.True(t, quote.Success)
+	require.True(t, quote.Resting)
+	require.Equal(t, types.ExpireReason_Unspecified, quote.ExpireReason)
+	require.True(t, quote.AveragePrice.IsZero())
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+
+	// Orders are validated as when they are placed
+	_, err = queryClient.Quote(ctx.Context(), &types.QueryQuoteRequest{
+		Owner:       acc2.GetAddress().String(),
+		Source:      coin("10000usd"),
+		Destination: coin("100eur"),
+	})
+	require.Error(t, err)
+
+	_, err = queryClient.Quote(ctx.Context(), &types.QueryQuoteRequest{
+		Owner:       "invalid",
+		Source:      coin("10usd"),
+		Destination: coin("100eur"),
+	})
+	require.Error(t, err)
+
+	_, err = k.Quote(sdk.WrapSDKContext(ctx), nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// recordOrder adds a completed order to the order history of its owner. The history can be queried by anyone, so an
+// iceberg order is recorded as it was displayed.
+func (k Keeper) recordOrder(ctx sdk.Context, order types.Order, status types.OrderStatus) {
+	historyLength := k.GetParams(ctx).OrderHistoryLength
+	if historyLength == 0 {
+		return
+	}
+
+	order = order.Redacted()
+
+	averagePrice := sdk.ZeroDec()
+	if order.SourceFilled.IsPositive() {
+		averagePrice = order.DestinationFilled.ToDec().QuoInt(order.SourceFilled)
+	}
+
+	record := types.OrderRecord{
+		OrderID:           order.ID,
+		Owner:             order.Owner,
+		ClientOrderID:     order.ClientOrderID,
+		Status:            status,
+		Source:            order.Source,
+		Destination:       order.Destination,
+		SourceFilled:      order.SourceFilled,
+		DestinationFilled: order.DestinationFilled,
+		AveragePrice:      averagePrice,
+		Created:           order.Created,
+		Height:            ctx.BlockHeight(),
+		Closed:            ctx.BlockTime(),
+	}
+
+	store := ctx.KVStore(k.key)
+
+	seqKey := types.GetOrderHistorySequenceKey(order.Owner)
+	sequence := uint64(0)
+	if bz := store.Get(seqKey); bz != nil {
+		sequence = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(seqKey, sdk.Uint64ToBigEndian(sequence+1))
+
+	record.ID = sequence
+	store.Set(types.GetOrderHistoryKey(order.Owner, sequence), k.cdc.MustMarshal(&record))
+
+	// Prune all records beyond the history length, which may have been reduced since the last record.
+	if sequence+1 > uint64(historyLength) {
+		end := types.GetOrderHistoryKey(order.Owner, sequence+1-uint64(historyLength))
+		deleteRange(store, types.GetOrderHistoryKeyPrefix(order.Owner), end)
+	}
+}
+
+// GetOrderHistory returns the completed orders of an account, oldest first.
+func (k Keeper) GetOrderHistory(ctx sdk.Context, owner sdk.AccAddress, pageReq *query.PageRequest) ([]types.OrderRecord, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.GetOrderHistoryKeyPrefix(owner.String()))
+
+	records := make([]types.OrderRecord, 0)
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		var record types.OrderRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		records = append(records, record)
+		return nil
+	})
+
+	return records, pageRes, err
+}
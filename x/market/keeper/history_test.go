@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderHistory(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	t0 := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(t0).WithBlockHeight(5)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	passive := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	canceled := order(ctx.BlockTime(), acc1, "100eur", "150usd")
+	require.NoError(t, k.NewOrderSingle(ctx, passive))
+	require.NoError(t, k.NewOrderSingle(ctx, canceled))
+
+	// Active orders are not part of the history
+	history, _, err := k.GetOrderHistory(ctx, acc1.GetAddress(), nil)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	ctx = ctx.WithBlockTime(t0.Add(time.Minute)).WithBlockHeight(6)
+
+	aggressive := order(ctx.BlockTime(), acc2, "60usd", "50eur")
+	require.NoError(t, k.NewOrderSingle(ctx, aggressive))
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), canceled.ClientOrderID))
+
+	ioc := order(ctx.BlockTime(), acc2, "200usd", "100eur")
+	ioc.TimeInForce = types.TimeInForce_ImmediateOrCancel
+	require.NoError(t, k.NewOrderSingle(ctx, ioc))
+
+	history, _, err = k.GetOrderHistory(ctx, acc1.GetAddress(), nil)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	record := history[0]
+	require.Equal(t, uint64(0), record.ID)
+	require.Equal(t, canceled.ClientOrderID, record.ClientOrderID)
+	require.Equal(t, types.OrderStatus_Canceled, record.Status)
+	require.True(t, record.SourceFilled.IsZero())
+	require.True(t, record.AveragePrice.IsZero())
+
+	// The passive order was filled by both aggressive orders
+	record = history[1]
+	require.Equal(t, uint64(1), record.ID)
+	require.Equal(t, passive.ClientOrderID, record.ClientOrderID)
+	require.Equal(t, types.OrderStatus_Filled, record.Status)
+	require.Equal(t, sdk.NewInt(100), record.SourceFilled)
+	require.Equal(t, sdk.NewInt(120), record.DestinationFilled)
+	require.Equal(t, sdk.MustNewDecFromStr("1.2"), record.AveragePrice)
+	require.True(t, t0.Equal(record.Created))
+	require.True(t, t0.Add(time.Minute).Equal(record.Closed))
+	require.Equal(t, int64(6), record.Height)
+
+	history, _, err = k.GetOrderHistory(ctx, acc2.GetAddress(), nil)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, aggressive.ClientOrderID, history[0].ClientOrderID)
+	require.Equal(t, types.OrderStatus_Filled, history[0].Status)
+	require.Equal(t, ioc.ClientOrderID, history[1].ClientOrderID)
+	require.Equal(t, types.OrderStatus_Expired, history[1].Status)
+	require.Equal(t, sdk.NewInt(60), history[1].SourceFilled)
+	require.Equal(t, sdk.NewInt(50), history[1].DestinationFilled)
+}
+
+func TestOrderHistoryExpiry(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	t0 := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(t0)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur,100chf")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	gtt := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	gtt.TimeInForce = types.TimeInForce_GoodTillTime
+	expiry := t0.Add(time.Hour)
+	gtt.GoodTillTime = &expiry
+	require.NoError(t, k.NewOrderSingle(ctx, gtt))
+
+	// Orders that can no longer be funded expire
+	unfunded := order(ctx.BlockTime(), acc1, "100chf", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, unfunded))
+	require.NoError(t, bk.SendCoins(ctx, acc1.GetAddress(), acc2.GetAddress(), coins("100chf")))
+
+	k.ExpireTimedOrders(ctx.WithBlockTime(expiry))
+
+	history, _, err := k.GetOrderHistory(ctx, acc1.GetAddress(), nil)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	for _, record := range history {
+		require.Equal(t, types.OrderStatus_Expired, record.Status)
+	}
+	require.Equal(t, unfunded.ClientOrderID, history[0].ClientOrderID)
+	require.Equal(t, gtt.ClientOrderID, history[1].ClientOrderID)
+}
+
+func TestOrderHistoryKilledOrder(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	passive := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, passive))
+
+	// The fill-or-kill order can only be partially filled and is killed
+	fok := order(ctx.BlockTime(), acc2, "240usd", "200eur")
+	fok.TimeInForce = types.TimeInForce_FillOrKill
+	require.NoError(t, k.NewOrderSingle(ctx, fok))
+
+	history, _, err := k.GetOrderHistory(ctx, acc2.GetAddress(), nil)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, fok.ClientOrderID, history[0].ClientOrderID)
+	require.Equal(t, types.OrderStatus_Expired, history[0].Status)
+	require.Equal(t, fok.Source, history[0].Source)
+	require.True(t, history[0].SourceFilled.IsZero())
+	require.True(t, history[0].DestinationFilled.IsZero())
+
+	// The rolled back fill leaves no trace in the history of the passive order
+	history, _, err = k.GetOrderHistory(ctx, acc1.GetAddress(), nil)
+	require.NoError(t, err)
+	require.Empty(t, history)
+	require.Equal(t, sdk.NewInt(100), k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), passive.ClientOrderID).SourceRemaining)
+}
+
+func TestOrderHistoryPruningAndQuery(t *testing.T) {
+	enc := MakeTestEncodingConfig()
+	ctx, k, ak, bk := createTestComponentsWithEncoding(t, enc)
+
+	params := types.DefaultParams()
+	params.OrderHistoryLength = 2
+	k.SetParams(ctx, params)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+
+	var cids []string
+	for i := 0; i < 3; i++ {
+		o := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+		require.NoError(t, k.NewOrderSingle(ctx, o))
+		require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), o.ClientOrderID))
+		cids = append(cids, o.ClientOrderID)
+	}
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, enc.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	// Older records are pruned beyond the history length
+	res, err := queryClient.OrderHistory(ctx.Context(), &types.QueryOrderHistoryRequest{Address: acc1.GetAddress().String()})
+	require.NoError(t, err)
+	require.Len(t, res.Orders, 2)
+	require.Equal(t, uint64(1), res.Orders[0].ID)
+	require.Equal(t, cids[1], res.Orders[0].ClientOrderID)
+	require.Equal(t, cids[2], res.Orders[1].ClientOrderID)
+
+	res, err = queryClient.OrderHistory(ctx.Context(), &types.QueryOrderHistoryRequest{
+		Address:    acc1.GetAddress().String(),
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Orders, 1)
+	require.Equal(t, cids[2], res.Orders[0].ClientOrderID)
+
+	_, err = queryClient.OrderHistory(ctx.Context(), &types.QueryOrderHistoryRequest{Address: "foo"})
+	require.Error(t, err)
+
+	// A history length of zero disables the history
+	params.OrderHistoryLength = 0
+	k.SetParams(ctx, params)
+
+	o := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), o.ClientOrderID))
+
+	history, _, err := k.GetOrderHistory(ctx, acc1.GetAddress(), nil)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// SetInstrumentConfig replaces the module-wide tick size, lot size and minimum notional for the orders that sell src
+// for dst. Orders already in the book are not affected.
+func (k *Keeper) SetInstrumentConfig(ctx sdk.Context, src, dst string, tickSize sdk.Dec, lotSize, minNotional sdk.Int) error {
+	config := types.NewInstrumentConfig(src, dst, tickSize, lotSize, minNotional)
+	if err := config.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidInstrument, err.Error())
+	}
+
+	k.setInstrumentConfig(ctx, config)
+	return nil
+}
+
+// RemoveInstrumentConfig reverts the src/dst instrument to the module-wide defaults.
+func (k *Keeper) RemoveInstrumentConfig(ctx sdk.Context, src, dst string) error {
+	if k.GetInstrumentConfig(ctx, src, dst) == nil {
+		return sdkerrors.Wrapf(types.ErrInstrumentConfigNotFound, "%v/%v", src, dst)
+	}
+
+	idxStore := ctx.KVStore(k.keyIndices)
+	idxStore.Delete(types.GetInstrumentConfigKey(src, dst))
+	return nil
+}
+
+func (k Keeper) setInstrumentConfig(ctx sdk.Context, config types.InstrumentConfig) {
+	idxStore := ctx.KVStore(k.keyIndices)
+	idxStore.Set(types.GetInstrumentConfigKey(config.Source, config.Destination), k.cdc.MustMarshal(&config))
+}
+
+// GetInstrumentConfig returns the configuration of the src/dst instrument, or nil if it uses the module-wide defaults.
+func (k Keeper) GetInstrumentConfig(ctx sdk.Context, src, dst string) *types.InstrumentConfig {
+	bz := ctx.KVStore(k.keyIndices).Get(types.GetInstrumentConfigKey(src, dst))
+	if bz == nil {
+		return nil
+	}
+
+	config := new(types.InstrumentConfig)
+	k.cdc.MustUnmarshal(bz, config)
+	return config
+}
+
+// GetInstrumentConfigs returns the configurations of all configured instruments.
+func (k Keeper) GetInstrumentConfigs(ctx sdk.Context) []types.InstrumentConfig {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	it := sdk.KVStorePrefixIterator(idxStore, types.GetInstrumentConfigPrefix())
+	defer it.Close()
+
+	configs := make([]types.InstrumentConfig, 0)
+	for ; it.Valid(); it.Next() {
+		var config types.InstrumentConfig
+		k.cdc.MustUnmarshal(it.Value(), &config)
+		configs = append(configs, config)
+	}
+
+	return configs
+}
+
+// instrumentConfig returns the configuration that applies to the src/dst instrument.
+func (k Keeper) instrumentConfig(ctx sdk.Context, params types.Params, src, dst string) types.InstrumentConfig {
+	if config := k.GetInstrumentConfig(ctx, src, dst); config != nil {
+		return *config
+	}
+
+	return params.DefaultInstrumentConfig(src, dst)
+}
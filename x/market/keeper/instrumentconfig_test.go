@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstrumentConfig(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	require.ErrorIs(t, k.SetInstrumentConfig(ctx, "eur", "eur", sdk.ZeroDec(), sdk.ZeroInt(), sdk.ZeroInt()), types.ErrInvalidInstrument)
+	require.ErrorIs(t, k.SetInstrumentConfig(ctx, "eur", "usd", sdk.NewDec(-1), sdk.ZeroInt(), sdk.ZeroInt()), types.ErrInvalidInstrument)
+	require.NoError(t, k.SetInstrumentConfig(ctx, "eur", "usd", sdk.NewDecWithPrec(1, 2), sdk.NewInt(10), sdk.NewInt(50)))
+
+	// Prices are verified without rounding
+	err := k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "300eur", "370usd"))
+	require.ErrorIs(t, err, types.ErrInvalidTickSize)
+	err = k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "105eur", "126usd"))
+	require.ErrorIs(t, err, types.ErrInvalidLotSize)
+	err = k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "40eur", "48usd"))
+	require.ErrorIs(t, err, types.ErrBelowMinNotional)
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "121usd")))
+
+	// Orders that cannot rest in the book only need to meet the minimum notional
+	ioc := order(ctx.BlockTime(), acc1, "33eur", "50usd")
+	ioc.TimeInForce = types.TimeInForce_ImmediateOrCancel
+	require.NoError(t, k.NewOrderSingle(ctx, ioc))
+	ioc = order(ctx.BlockTime(), acc1, "33eur", "49usd")
+	ioc.TimeInForce = types.TimeInForce_ImmediateOrCancel
+	require.ErrorIs(t, k.NewOrderSingle(ctx, ioc), types.ErrBelowMinNotional)
+
+	// Replacement orders are verified as well. A failed replacement is rolled back with its transaction.
+	orig := k.GetOrdersByOwner(ctx, acc1.GetAddress())[0]
+	cacheCtx, _ := ctx.CacheContext()
+	err = k.CancelReplaceLimitOrder(cacheCtx, order(ctx.BlockTime(), acc1, "1000eur", "1205usd"), orig.ClientOrderID)
+	require.ErrorIs(t, err, types.ErrInvalidTickSize)
+	require.NoError(t, k.CancelReplaceLimitOrder(ctx, order(ctx.BlockTime(), acc1, "200eur", "242usd"), orig.ClientOrderID))
+
+	// The configuration only applies to the configured direction of the instrument, which uses the defaults otherwise
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "33usd", "30eur")))
+
+	params := k.GetParams(ctx)
+	params.LotSize = sdk.NewInt(5)
+	k.SetParams(ctx, params)
+	require.ErrorIs(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "33usd", "30eur")), types.ErrInvalidLotSize)
+
+	require.Len(t, k.GetInstrumentConfigs(ctx), 1)
+	require.NoError(t, k.RemoveInstrumentConfig(ctx, "eur", "usd"))
+	require.ErrorIs(t, k.RemoveInstrumentConfig(ctx, "eur", "usd"), types.ErrInstrumentConfigNotFound)
+	require.Empty(t, k.GetInstrumentConfigs(ctx))
+	require.ErrorIs(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "33eur", "37usd")), types.ErrInvalidLotSize)
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "35eur", "37usd")))
+}
+
+func TestInstrumentConfigPostOnlyReprice(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	require.NoError(t, k.SetInstrumentConfig(ctx, "usd", "eur", sdk.NewDecWithPrec(1, 2), sdk.ZeroInt(), sdk.ZeroInt()))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+
+	// Just behind the best price of 0.8333 is 251eur, which is raised to the next tick of 0.84
+	o := order(ctx.BlockTime(), acc2, "300usd", "200eur")
+	o.PostOnly = types.PostOnlyMode_Reprice
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+
+	repriced := k.GetOrderByOwnerAndClientOrderId(ctx, acc2.GetAddress().String(), o.ClientOrderID)
+	require.NotNil(t, repriced)
+	require.Equal(t, coin("300usd"), repriced.Source)
+	require.Equal(t, coin("252eur"), repriced.Destination)
+	require.Equal(t, sdk.NewDecWithPrec(84, 2), repriced.Price())
+	require.Equal(t, "5000usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+}
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// RegisterInvariants registers the market module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "order-indices", OrderIndicesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "non-negative-remaining", NonNegativeRemainingInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "instrument-demand", InstrumentDemandInvariant(k))
+}
+
+// AllInvariants runs all invariants of the market module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			OrderIndicesInvariant(k),
+			NonNegativeRemainingInvariant(k),
+			InstrumentDemandInvariant(k),
+		} {
+			if res, stop := inv(ctx); stop {
+				return res, stop
+			}
+		}
+
+		return "", false
+	}
+}
+
+// OrderIndicesInvariant checks that every order stored by owner has an identical entry in the priority index and an
+// entry in the order ID and owner denomination indices, and vice versa.
+func OrderIndicesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			store    = ctx.KVStore(k.key)
+			idxStore = ctx.KVStore(k.keyIndices)
+			msg      string
+			count    int
+		)
+
+		ownerIt := sdk.KVStorePrefixIterator(store, types.GetOwnersPrefix())
+		defer ownerIt.Close()
+
+		for ; ownerIt.Valid(); ownerIt.Next() {
+			order := new(types.Order)
+			k.cdc.MustUnmarshal(ownerIt.Value(), order)
+
+			priorityKey := types.GetPriorityKey(order.Source.Denom, order.Destination.Denom, order.Price(), order.PriorityID())
+			if !bytes.Equal(idxStore.Get(priorityKey), ownerIt.Value()) {
+				count++
+				msg += fmt.Sprintf("\torder %d of %v has no matching priority entry\n", order.ID, order.Owner)
+			}
+
+			if !bytes.Equal(idxStore.Get(types.GetOrderIDKey(order.ID)), ownerIt.Key()) {
+				count++
+				msg += fmt.Sprintf("\torder %d of %v has no matching order id entry\n", order.ID, order.Owner)
+			}
+
+			ownerDenomKey := types.GetOwnerDenomKey(order.Owner, order.Source.Denom, order.ClientOrderID)
+			if !bytes.Equal(idxStore.Get(ownerDenomKey), ownerIt.Key()) {
+				count++
+				msg += fmt.Sprintf("\torder %d of %v has no matching owner denomination entry\n", order.ID, order.Owner)
+			}
+		}
+
+		priorityIt := sdk.KVStorePrefixIterator(idxStore, types.GetPriorityKeyPrefix())
+		defer priorityIt.Close()
+
+		for ; priorityIt.Valid(); priorityIt.Next() {
+			order := new(types.Order)
+			k.cdc.MustUnmarshal(priorityIt.Value(), order)
+
+			ownerKey := types.GetOwnerKey(order.Owner, order.ClientOrderID)
+			if !bytes.Equal(store.Get(ownerKey), priorityIt.Value()) {
+				count++
+				msg += fmt.Sprintf("\tpriority entry of order %d of %v has no matching order\n", order.ID, order.Owner)
+			}
+		}
+
+		idIt := sdk.KVStorePrefixIterator(idxStore, types.GetOrderIDPrefix())
+		defer idIt.Close()
+
+		for ; idIt.Valid(); idIt.Next() {
+			orderID := sdk.BigEndianToUint64(idIt.Key()[len(types.GetOrderIDPrefix()):])
+
+			bz := store.Get(idIt.Value())
+			if bz == nil {
+				count++
+				msg += fmt.Sprintf("\torder id entry of order %d has no matching order\n", orderID)
+				continue
+			}
+
+			order := new(types.Order)
+			k.cdc.MustUnmarshal(bz, order)
+			if order.ID != orderID {
+				count++
+				msg += fmt.Sprintf("\torder id entry of order %d refers to order %d\n", orderID, order.ID)
+			}
+		}
+
+		ownerDenomIt := sdk.KVStorePrefixIterator(idxStore, types.GetOwnerDenomPrefix())
+		defer ownerDenomIt.Close()
+
+		for ; ownerDenomIt.Valid(); ownerDenomIt.Next() {
+			bz := store.Get(ownerDenomIt.Value())
+			if bz == nil {
+				count++
+				msg += fmt.Sprintf("\towner denomination entry %X has no matching order\n", ownerDenomIt.Key())
+				continue
+			}
+
+			order := new(types.Order)
+			k.cdc.MustUnmarshal(bz, order)
+			if !bytes.Equal(ownerDenomIt.Key(), types.GetOwnerDenomKey(order.Owner, order.Source.Denom, order.ClientOrderID)) {
+				count++
+				msg += fmt.Sprintf("\towner denomination entry %X refers to order %d\n", ownerDenomIt.Key(), order.ID)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "order-indices",
+			fmt.Sprintf("found %d order index mismatches\n%s", count, msg),
+		), broken
+	}
+}
+
+// NonNegativeRemainingInvariant checks that no order has a negative remaining source amount.
+func NonNegativeRemainingInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		for _, order := range k.GetAllOrders(ctx) {
+			if order.SourceRemaining.IsNegative() {
+				count++
+				msg += fmt.Sprintf("\torder %d of %v has source remaining %v\n", order.ID, order.Owner, order.SourceRemaining)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "non-negative-remaining",
+			fmt.Sprintf("found %d orders with a negative source remaining\n%s", count, msg),
+		), broken
+	}
+}
+
+// InstrumentDemandInvariant checks that the remaining source amounts of the orders of each owner in each instrument
+// are covered by the owner's spendable balance. Orders with a pending balance change may exceed it until they are
+// re-evaluated in EndBlock, so they are verified as the next EndBlock leaves them.
+func InstrumentDemandInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		// The re-evaluation only changes the orders with a pending balance change, and is discarded.
+		ctx, _ = ctx.CacheContext()
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+		k.ReevaluatePendingBalanceChanges(ctx)
+
+		type ownerInstrument struct {
+			owner, source, destination string
+		}
+
+		// Orders are sorted by owner, so the spendable balance is only looked up once per owner.
+		var (
+			demand    = make(map[ownerInstrument]sdk.Int)
+			instrs    []ownerInstrument
+			spendable = make(map[string]sdk.Coins)
+		)
+
+		for _, order := range k.GetAllOrders(ctx) {
+			key := ownerInstrument{order.Owner, order.Source.Denom, order.Destination.Denom}
+			if _, found := demand[key]; !found {
+				demand[key] = sdk.ZeroInt()
+				instrs = append(instrs, key)
+			}
+			demand[key] = demand[key].Add(order.SourceRemaining)
+
+			if _, found := spendable[order.Owner]; !found {
+				spendable[order.Owner] = k.bk.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(order.Owner))
+			}
+		}
+
+		for _, key := range instrs {
+			balance := spendable[key.owner].AmountOf(key.source)
+			if demand[key].GT(balance) {
+				count++
+				msg += fmt.Sprintf("\t%v has orders for %v%v in %v/%v, but only %v%v is spendable\n",
+					key.owner, demand[key], key.source, key.source, key.destination, balance, key.source)
+			}
+		}
+
+		broken := count != 0
+		return sdk.FormatInvariant(
+			types.ModuleName, "instrument-demand",
+			fmt.Sprintf("found %d instruments with demand exceeding spendable balances\n%s", count, msg),
+		), broken
+	}
+}
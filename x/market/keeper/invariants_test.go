@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+type invariantRegistry map[string]sdk.Invariant
+
+func (r invariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	r[moduleName+"/"+route] = invar
+}
+
+func TestInvariantsRegistered(t *testing.T) {
+	_, k, _, _ := createTestComponents(t)
+
+	registry := make(invariantRegistry)
+	RegisterInvariants(registry, k)
+
+	require.Contains(t, registry, "market/order-indices")
+	require.Contains(t, registry, "market/non-negative-remaining")
+	require.Contains(t, registry, "market/instrument-demand")
+}
+
+func TestInvariants(t *testing.T) {
+	setup := func(t *testing.T) (sdk.Context, *Keeper, *types.Order) {
+		ctx, k, ak, bk := createTestComponents(t)
+
+		acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+		acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "300eur", "400usd")))
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+
+		_, broken := AllInvariants(k)(ctx)
+		require.False(t, broken)
+
+		return ctx, k, k.GetOrdersByOwner(ctx, acc1.GetAddress())[0]
+	}
+
+	specs := map[string]struct {
+		corrupt   func(ctx sdk.Context, k *Keeper, o *types.Order)
+		invariant func(k *Keeper) sdk.Invariant
+	}{
+		"missing priority entry": {
+			corrupt: func(ctx sdk.Context, k *Keeper, o *types.Order) {
+				ctx.KVStore(k.keyIndices).Delete(types.GetPriorityKey(o.Source.Denom, o.Destination.Denom, o.Price(), o.ID))
+			},
+			invariant: OrderIndicesInvariant,
+		},
+		"missing owner entry": {
+			corrupt: func(ctx sdk.Context, k *Keeper, o *types.Order) {
+				ctx.KVStore(k.key).Delete(types.GetOwnerKey(o.Owner, o.ClientOrderID))
+			},
+			invariant: OrderIndicesInvariant,
+		},
+		"missing order id entry": {
+			corrupt: func(ctx sdk.Context, k *Keeper, o *types.Order) {
+				ctx.KVStore(k.keyIndices).Delete(types.GetOrderIDKey(o.ID))
+			},
+			invariant: OrderIndicesInvariant,
+		},
+		"dangling order id entry": {
+			corrupt: func(ctx sdk.Context, k *Keeper, o *types.Order) {
+				ctx.KVStore(k.keyIndices).Set(types.GetOrderIDKey(o.ID+100), types.GetOwnerKey(o.Owner, o.ClientOrderID))
+			},
+			invariant: OrderIndicesInvariant,
+		},
+		"stale priority entry": {
+			corrupt: func(ctx sdk.Context, k *Keeper, o *types.Order) {
+				updated := *o
+				updated.SourceRemaining = updated.SourceRemaining.SubRaw(1)
+				ctx.KVStore(k.key).Set(types.GetOwnerKey(o.Owner, o.ClientOrderID), k.cdc.MustMarshal(&updated))
+			},
+			invariant: OrderIndicesInvariant,
+		},
+		"negative source remaining": {
+			corrupt: func(ctx sdk.Context, k *Keeper, o *types.Order) {
+				o.SourceRemaining = sdk.NewInt(-1)
+				k.setOrder(ctx, o)
+			},
+			invariant: NonNegativeRemainingInvariant,
+		},
+		"demand exceeds spendable balance": {
+			corrupt: func(ctx sdk.Context, k *Keeper, o *types.Order) {
+				o.SourceRemaining = sdk.NewInt(5000)
+				k.setOrder(ctx, o)
+			},
+			invariant: InstrumentDemandInvariant,
+		},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, k, o := setup(t)
+			spec.corrupt(ctx, k, o)
+
+			msg, broken := spec.invariant(k)(ctx)
+			require.True(t, broken, msg)
+
+			_, broken = AllInvariants(k)(ctx)
+			require.True(t, broken)
+		})
+	}
+}
+
+func TestInstrumentDemandAfterBalanceChange(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "500eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd,5000chf")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "200eur", "240usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "200eur", "250usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "300eur", "400chf")))
+
+	// The remaining balance is shared by the orders of each instrument
+	require.NoError(t, bk.SendCoins(ctx, acc1.GetAddress(), acc2.GetAddress(), coins("250eur")))
+
+	msg, broken := InstrumentDemandInvariant(k)(ctx)
+	require.False(t, broken, msg)
+
+	demand := make(map[string]sdk.Int)
+	for _, o := range k.GetOrdersByOwner(ctx, acc1.GetAddress()) {
+		if _, found := demand[o.Destination.Denom]; !found {
+			demand[o.Destination.Denom] = sdk.ZeroInt()
+		}
+		demand[o.Destination.Denom] = demand[o.Destination.Denom].Add(o.SourceRemaining)
+	}
+	require.Equal(t, sdk.NewInt(250), demand["usd"])
+	require.Equal(t, sdk.NewInt(250), demand["chf"])
+}
+
+func TestInstrumentDemandAfterFill(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "500eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	// The orders reserve the full balance, so the filled order must not be allocated any of it during settlement
+	o1 := order(ctx.BlockTime(), acc1, "200eur", "240usd")
+	o2 := order(ctx.BlockTime(), acc1, "300eur", "390usd")
+	o1.ClientOrderID, o2.ClientOrderID = "a", "b"
+	require.NoError(t, k.NewOrderSingle(ctx, o1))
+	require.NoError(t, k.NewOrderSingle(ctx, o2))
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "240usd", "200eur")))
+
+	orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 1)
+	require.Equal(t, sdk.NewInt(300), orders[0].SourceRemaining)
+
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
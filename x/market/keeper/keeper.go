@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/e-money/em-ledger/x/market/types"
 )
 
@@ -20,6 +21,13 @@ const (
 	gasPriceNewOrder           = uint64(25000)
 	gasPriceCancelReplaceOrder = uint64(25000)
 	gasPriceCancelOrder        = uint64(12500)
+
+	// Canceling all orders is charged per order examined, whether it matches the instrument filter or not.
+	gasPriceCancelAllOrders         = uint64(12500)
+	gasPriceCancelAllOrdersPerOrder = uint64(2500)
+
+	// Maximum number of passive orders in an execution plan, i.e. at most two intermediate denominations.
+	maxExecutionPlanLegs = 3
 )
 
 var _ marketKeeper = &Keeper{}
@@ -32,17 +40,29 @@ type Keeper struct {
 	ak types.AccountKeeper
 	bk types.BankKeeper
 
+	paramSpace paramtypes.Subspace
+
+	// Name of the module account that receives trading fees.
+	feeRecipient string
+
 	// accountOrders types.Orders
 	appstateInit *sync.Once
 }
 
-func NewKeeper(cdc codec.Codec, key sdk.StoreKey, keyIndices sdk.StoreKey, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper) *Keeper {
+func NewKeeper(cdc codec.Codec, key sdk.StoreKey, keyIndices sdk.StoreKey, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper, paramSpace paramtypes.Subspace, feeRecipient string) *Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	k := &Keeper{
 		cdc:        cdc,
 		key:        key,
 		keyIndices: keyIndices,
 		ak:         authKeeper,
 		bk:         bankKeeper,
+		paramSpace: paramSpace,
+
+		feeRecipient: feeRecipient,
 
 		appstateInit: new(sync.Once),
 	}
@@ -51,59 +71,88 @@ func NewKeeper(cdc codec.Codec, key sdk.StoreKey, keyIndices sdk.StoreKey, authK
 	return k
 }
 
+// createExecutionPlan finds the best priced path of at most maxExecutionPlanLegs passive orders that converts
+// SourceDenom into DestinationDenom. Paths with equal prices are ranked by their number of legs and then by the
+// instrument key order of their denominations, so that the plan is deterministic.
 func (k *Keeper) createExecutionPlan(ctx sdk.Context, SourceDenom, DestinationDenom string) types.ExecutionPlan {
 	bestPlan := types.ExecutionPlan{
 		Price: sdk.NewDec(math.MaxInt64),
 	}
 
-	instruments := k.GetInstruments(ctx)
-
-	for _, firstInstrument := range instruments {
-		//_, firstDenom := types.MustParseInstrumentKey(firstIt.Key())
-
-		if firstInstrument.Source != SourceDenom {
+	// Instruments are iterated in key order, which determines the search order. Halted instruments are not traded,
+	// not even as part of a synthetic instrument.
+	halted := k.getHaltedInstruments(ctx)
+	destinations := make(map[string][]string)
+	for _, instrument := range k.GetInstruments(ctx) {
+		if halted[string(types.GetTradingHaltKey(instrument.Source, instrument.Destination))] {
 			continue
 		}
 
-		// firstPassiveOrder := firstInstrument.Orders.LeftKey().(*types.Order)
-		firstPassiveOrder := k.getBestOrder(ctx, SourceDenom, firstInstrument.Destination)
-		if firstPassiveOrder == nil {
-			continue
+		destinations[instrument.Source] = append(destinations[instrument.Source], instrument.Destination)
+	}
+
+	type instrumentKey struct{ source, destination string }
+	bestOrders := make(map[instrumentKey]*types.Order)
+	bestOrder := func(source, destination string) *types.Order {
+		key := instrumentKey{source, destination}
+		if o, found := bestOrders[key]; found {
+			return o
 		}
 
-		// Check direct price
-		if firstInstrument.Destination == DestinationDenom {
-			// Direct price is better than current plan
+		o := k.getBestOrder(ctx, source, destination)
+		bestOrders[key] = o
+		return o
+	}
 
-			planPrice := sdk.OneDec().Quo(firstPassiveOrder.Price())
-			planPrice = planPrice.Add(sdk.NewDecWithPrec(1, sdk.Precision)) // Add floating point epsilon
-			if planPrice.LT(bestPlan.Price) {
-				bestPlan = types.ExecutionPlan{
-					Price:      planPrice,
-					FirstOrder: firstPassiveOrder,
-				}
+	var (
+		path    []*types.Order
+		visited = map[string]bool{SourceDenom: true}
+		search  func(denom string, pathPrice sdk.Dec)
+	)
+
+	search = func(denom string, pathPrice sdk.Dec) {
+		for _, next := range destinations[denom] {
+			if visited[next] {
+				continue
 			}
-		}
 
-		// Check synthetic price by going through two orders:
-		// (SourceDenom, X) -> (X, DestinationDenom)
-		secondPassiveOrder := k.getBestOrder(ctx, firstInstrument.Destination, DestinationDenom)
-		if secondPassiveOrder == nil {
-			continue
-		}
+			passiveOrder := bestOrder(denom, next)
+			if passiveOrder == nil {
+				continue
+			}
 
-		planPrice := sdk.OneDec().Quo(firstPassiveOrder.Price().Mul(secondPassiveOrder.Price()))
-		planPrice = planPrice.Add(sdk.NewDecWithPrec(1, sdk.Precision)) // Add floating point epsilon
+			price := pathPrice.Mul(passiveOrder.Price())
+			if price.IsZero() {
+				// Too small to be represented, so the path cannot be traded.
+				continue
+			}
+
+			path = append(path, passiveOrder)
+
+			if next == DestinationDenom {
+				planPrice := sdk.OneDec().Quo(price)
+				planPrice = planPrice.Add(sdk.NewDecWithPrec(1, sdk.Precision)) // Add floating point epsilon
 
-		if planPrice.LT(bestPlan.Price) {
-			bestPlan = types.ExecutionPlan{
-				Price:       planPrice,
-				FirstOrder:  firstPassiveOrder,
-				SecondOrder: secondPassiveOrder,
+				if planPrice.LT(bestPlan.Price) || (planPrice.Equal(bestPlan.Price) && len(path) < len(bestPlan.Orders)) {
+					bestPlan = types.ExecutionPlan{
+						Price:  planPrice,
+						Orders: append([]*types.Order(nil), path...),
+					}
+				}
+			} else if len(path) < maxExecutionPlanLegs {
+				// Check synthetic prices by going through intermediate denominations:
+				// (SourceDenom, X) -> (X, Y) -> (Y, DestinationDenom)
+				visited[next] = true
+				search(next, price)
+				visited[next] = false
 			}
+
+			path = path[:len(path)-1]
 		}
 	}
 
+	search(SourceDenom, sdk.OneDec())
+
 	return bestPlan
 }
 
@@ -137,24 +186,86 @@ func (k *Keeper) GetSrcFromSlippage(
 	return slippageSource, nil
 }
 
+// GetDstFromSlippage expresses the minimum destination amount to buy for the
+// src amount. The best price in the book, direct or synthetic, is reduced by
+// the slippage percentage and applied to the src amount. Instruments without
+// any orders to match cannot be priced.
+func (k *Keeper) GetDstFromSlippage(
+	ctx sdk.Context, src sdk.Coin, dstDenom string, maxSlippage sdk.Dec,
+) (sdk.Coin, error) {
+	if maxSlippage.IsNegative() || maxSlippage.GTE(sdk.OneDec()) {
+		return sdk.Coin{}, sdkerrors.Wrapf(
+			types.ErrInvalidSlippage,
+			"slippage must be at least zero and less than one: %s", maxSlippage.String(),
+		)
+	}
+
+	bestPrice := k.GetBestPrice(ctx, src.Denom, dstDenom)
+	if bestPrice == nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(types.ErrNoLiquidity, "%v/%v", src.Denom, dstDenom)
+	}
+
+	destination := bestPrice.MulInt(src.Amount).Mul(sdk.OneDec().Sub(maxSlippage)).TruncateInt()
+	if !destination.IsPositive() {
+		return sdk.Coin{}, sdkerrors.Wrapf(
+			types.ErrInvalidPrice, "%v is too small to buy any %v", src, dstDenom,
+		)
+	}
+
+	return sdk.NewCoin(dstDenom, destination), nil
+}
+
 func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) error {
-	// save caller's event manager
-	retEvManager := ctx.EventManager()
+	// Use a fixed gas amount
+	ctx.GasMeter().ConsumeGas(gasPriceNewOrder, "NewOrderSingle")
+
+	return k.newOrderSingle(ctx, ctx.GasMeter(), aggressiveOrder, false)
+}
+
+// NewSourceMarketOrder places an order that sells its entire source amount for as many destination tokens as the book
+// offers. The destination amount of the order only sets the lowest acceptable price, as opposed to the amount to buy.
+// The remainder of the order is never placed in the book.
+func (k *Keeper) NewSourceMarketOrder(ctx sdk.Context, order types.Order) error {
+	if order.TimeInForce != types.TimeInForce_ImmediateOrCancel && order.TimeInForce != types.TimeInForce_FillOrKill {
+		return sdkerrors.Wrapf(types.ErrUnknownTimeInForce, "source market orders are either %v or %v", types.TimeInForce_ImmediateOrCancel, types.TimeInForce_FillOrKill)
+	}
 
 	// Use a fixed gas amount
 	ctx.GasMeter().ConsumeGas(gasPriceNewOrder, "NewOrderSingle")
+
+	return k.newOrderSingle(ctx, ctx.GasMeter(), order, true)
+}
+
+// newOrderSingle places the aggressive order. If sellSource is set, fills are not limited by the destination amount of
+// the order and it is filled once its source is spent. The conditional orders triggered by the order are charged to
+// gasMeter, the fixed price of the order is charged by the caller.
+func (k *Keeper) newOrderSingle(ctx sdk.Context, gasMeter sdk.GasMeter, aggressiveOrder types.Order, sellSource bool) error {
+	// save caller's event manager
+	retEvManager := ctx.EventManager()
+
 	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
 
 	// Set this to true to roll back any state changes made by the aggressive order. Used for FillOrKill orders.
 	KillOrder := false
+	parentCtx := ctx
 	ctx, commitTrade := ctx.CacheContext()
 
+	// Circuit breakers whose price band stopped the aggressive order from matching.
+	var priceBandBreaches []types.CircuitBreaker
+
+	// The aggressive order as accepted, before any fills.
+	var acceptedOrder types.Order
+
 	defer func() {
-		if KillOrder {
-			return
+		if !KillOrder {
+			commitTrade()
+		} else {
+			// The fills of a killed order are rolled back, so it is recorded as it was accepted.
+			k.recordOrder(parentCtx, acceptedOrder, types.OrderStatus_Expired)
 		}
 
-		commitTrade()
+		// Breaches count towards halting the instrument, even if the order is killed.
+		k.recordPriceBandBreaches(parentCtx, priceBandBreaches)
 	}()
 
 	if err := aggressiveOrder.IsValid(); err != nil {
@@ -168,6 +279,10 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 		)
 	}
 
+	if aggressiveOrder.IsExpired(ctx.BlockTime(), ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(types.ErrInvalidExpiry, "Order has already expired")
+	}
+
 	owner, err := sdk.AccAddressFromBech32(aggressiveOrder.Owner)
 	if err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
@@ -196,7 +311,8 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 	}
 
 	// Verify uniqueness of client order id among active orders
-	if containsClientId(accountOrders, aggressiveOrder.ClientOrderID) {
+	if containsClientId(accountOrders, aggressiveOrder.ClientOrderID) ||
+		k.GetConditionalOrder(ctx, aggressiveOrder.Owner, aggressiveOrder.ClientOrderID) != nil {
 		return sdkerrors.Wrap(types.ErrNonUniqueClientOrderId, aggressiveOrder.ClientOrderID)
 	}
 
@@ -204,19 +320,67 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 	if !k.assetExists(ctx, aggressiveOrder.Destination) {
 		return sdkerrors.Wrap(types.ErrUnknownAsset, aggressiveOrder.Destination.Denom)
 	}
+
+	if k.GetTradingHalt(ctx, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom) != nil {
+		return sdkerrors.Wrapf(types.ErrTradingHalted, "%v/%v", aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom)
+	}
+
+	params := k.GetParams(ctx)
+
+	// Only orders that accept less than the band allows are rejected. Orders priced above the band cannot match until
+	// the band moves and are placed in the book.
+	if lower, _, found := k.priceBandLimits(ctx, params, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom); found && aggressiveOrder.Price().LT(lower) {
+		return sdkerrors.Wrapf(types.ErrPriceOutsideBand, "Order price %v is below the lower bound %v", aggressiveOrder.Price(), lower)
+	}
+
 	k.registerMarketData(ctx, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom)
 	k.registerMarketData(ctx, aggressiveOrder.Destination.Denom, aggressiveOrder.Source.Denom)
 
-	// Accept order
+	if aggressiveOrder.PostOnly != types.PostOnlyMode_Disabled {
+		if err := k.applyPostOnly(ctx, params, &aggressiveOrder); err != nil {
+			return err
+		}
+	}
+
+	// Verified after post-only orders have been re-priced, as they may rest in the book at the new price.
+	config := k.instrumentConfig(ctx, params, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom)
+	if err := config.ValidateOrder(aggressiveOrder); err != nil {
+		return err
+	}
+
+	// An iceberg order takes liquidity with its full size, but only displays a slice once it rests in the book.
+	if aggressiveOrder.IsIceberg() {
+		displayRemaining := *aggressiveOrder.DisplaySize
+		aggressiveOrder.DisplayRemaining = &displayRemaining
+	}
+
+	// Accept order. Like the order queries, the event only shows the displayed slice of an iceberg order.
 	aggressiveOrder.ID = k.getNextOrderNumber(ctx)
-	types.EmitAcceptEvent(ctx, aggressiveOrder)
+	types.EmitAcceptEvent(ctx, aggressiveOrder.Redacted())
+	acceptedOrder = aggressiveOrder
+
+	// Set when the remainder of the aggressive order is canceled by self-trade prevention.
+	selfTradeCanceled := false
+
+	isFilled := func() bool {
+		if sellSource {
+			return aggressiveOrder.SourceRemaining.ToDec().Mul(aggressiveOrder.Price()).LT(sdk.OneDec())
+		}
+		return aggressiveOrder.IsFilled()
+	}
 
 	for {
 		plan := k.createExecutionPlan(ctx, aggressiveOrder.Destination.Denom, aggressiveOrder.Source.Denom)
-		if plan.FirstOrder == nil {
+		if len(plan.Orders) == 0 {
 			break
 		}
 
+		// Passive orders whose owner's balance change is yet to be re-evaluated may exceed the balance, so they are
+		// re-evaluated and the plan is recreated.
+		if k.settlePendingBalanceChanges(ctx, plan.Orders) {
+			continue
+		}
+
 		if aggressiveOrder.Price().GT(plan.Price) {
 			// Spread has not been crossed. Aggressive order should be added to book.
 			break
@@ -229,22 +393,51 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 		stepDestinationFilled = sdk.MinDec(stepDestinationFilled, aggressiveOrder.SourceRemaining.ToDec())
 
 		// Do not purchase more destination tokens than the order warrants
-		aggressiveDestinationRemaining := aggressiveOrder.Destination.Amount.Sub(aggressiveOrder.DestinationFilled).ToDec().Quo(plan.Price).RoundInt()
-		stepDestinationFilled = sdk.MinDec(stepDestinationFilled, aggressiveDestinationRemaining.ToDec())
+		if !sellSource {
+			aggressiveDestinationRemaining := aggressiveOrder.Destination.Amount.Sub(aggressiveOrder.DestinationFilled).ToDec().Quo(plan.Price).RoundInt()
+			stepDestinationFilled = sdk.MinDec(stepDestinationFilled, aggressiveDestinationRemaining.ToDec())
+		}
 
 		if stepDestinationFilled.LT(sdk.OneDec()) {
 			// Executing this trade would transfer less than one token, so do not attempt it.
 			break
 		}
 
+		// Prevent the aggressive order from matching the orders of its owner if it requests so.
+		if selfTrades := plan.OrdersOf(aggressiveOrder.Owner); len(selfTrades) > 0 && aggressiveOrder.SelfTradePrevention != types.SelfTradePrevention_Disabled {
+			if aggressiveOrder.SelfTradePrevention.CancelsOldest() {
+				for _, passiveOrder := range selfTrades {
+					k.deleteOrder(ctx, passiveOrder)
+					types.EmitExpireEvent(ctx, *passiveOrder, types.ExpireReason_SelfTradeOldest)
+					k.recordOrder(ctx, *passiveOrder, types.OrderStatus_Canceled)
+				}
+			}
+
+			if aggressiveOrder.SelfTradePrevention.CancelsNewest() {
+				selfTradeCanceled = true
+				break
+			}
+
+			continue
+		}
+
+		// Stop matching rather than trade at a price outside of the band of a circuit breaker.
+		if breaches := k.priceBandBreaches(ctx, params, aggressiveOrder, plan); len(breaches) > 0 {
+			priceBandBreaches = breaches
+			break
+		}
+
+		// Read before the plan is settled and stored once it has been matched.
+		priceBands := k.planPriceBands(ctx, params, aggressiveOrder, plan)
+
 		// Track aggressive fill for event
 		aggressiveSourceFilled := sdk.ZeroInt()
 		aggressiveDestinationFilled := sdk.ZeroInt()
+		aggressiveFee := sdk.ZeroInt()
 
-		for _, passiveOrder := range []*types.Order{plan.SecondOrder, plan.FirstOrder} {
-			if passiveOrder == nil {
-				continue
-			}
+		// Settle the plan from the passive order that delivers the aggressive order's destination tokens and back.
+		for i := len(plan.Orders) - 1; i >= 0; i-- {
+			passiveOrder := plan.Orders[i]
 
 			// Use the passive order's price in the market.
 			stepSourceFilled := stepDestinationFilled.Quo(passiveOrder.Price())
@@ -266,13 +459,15 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 
 			if passiveOrder.Source.Denom == aggressiveOrder.Destination.Denom {
 				// In rare cases, the price improvement may cause extra tokens to be bought. Buy at most what is needed to fill the order.
-				stepSourceFilled = sdk.MinDec(stepSourceFilled, aggressiveOrder.Destination.Amount.Sub(aggressiveOrder.DestinationFilled).ToDec())
+				if !sellSource {
+					stepSourceFilled = sdk.MinDec(stepSourceFilled, aggressiveOrder.Destination.Amount.Sub(aggressiveOrder.DestinationFilled).ToDec())
+				}
 
 				aggressiveDestinationFilled = aggressiveDestinationFilled.Add(stepSourceFilled.RoundInt())
 				aggressiveOrder.DestinationFilled = aggressiveOrder.DestinationFilled.Add(stepSourceFilled.RoundInt())
 
 				// Invariant check
-				if aggressiveOrder.DestinationFilled.GT(aggressiveOrder.Destination.Amount) {
+				if !sellSource && aggressiveOrder.DestinationFilled.GT(aggressiveOrder.Destination.Amount) {
 					panic(fmt.Sprintf("Aggressive order's DestinationFilled field is greater than Destination.Amount. order: %v", aggressiveOrder))
 				}
 			}
@@ -280,6 +475,10 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 			passiveOrder.SourceRemaining = passiveOrder.SourceRemaining.Sub(stepSourceFilled.RoundInt())
 			passiveOrder.SourceFilled = passiveOrder.SourceFilled.Add(stepSourceFilled.RoundInt())
 			passiveOrder.DestinationFilled = passiveOrder.DestinationFilled.Add(stepDestinationFilled.RoundInt())
+			if passiveOrder.IsIceberg() {
+				displayRemaining := sdk.MaxInt(passiveOrder.DisplayRemaining.Sub(stepSourceFilled.RoundInt()), sdk.ZeroInt())
+				passiveOrder.DisplayRemaining = &displayRemaining
+			}
 
 			// Invariant checks
 			if passiveOrder.SourceRemaining.LT(sdk.ZeroInt()) {
@@ -292,19 +491,39 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 			// Settle traded tokens
 			nextDestinationFilledCoin := sdk.NewCoin(passiveOrder.Destination.Denom, stepDestinationFilled.RoundInt())
 			nextSourceFilledCoin := sdk.NewCoin(passiveOrder.Source.Denom, stepSourceFilled.RoundInt())
-			if err := k.transferTradedAmounts(ctx, nextDestinationFilledCoin, nextSourceFilledCoin, passiveOrder.Owner, aggressiveOrder.Owner); err != nil {
-				panic(err)
-			}
 
-			types.EmitFillEvent(ctx, *passiveOrder, false, stepSourceFilled.RoundInt(), stepDestinationFilled.RoundInt())
+			// Fees are deducted from the amounts received. The aggressive order only pays a fee on the final step, as
+			// the intermediate tokens of a synthetic instrument are passed on in full.
+			makerFee := params.MakerFee.MulInt(nextDestinationFilledCoin.Amount).TruncateInt()
+			takerFee := sdk.ZeroInt()
+			if passiveOrder.Source.Denom == aggressiveOrder.Destination.Denom {
+				takerFee = params.TakerFee.MulInt(nextSourceFilledCoin.Amount).TruncateInt()
+				aggressiveFee = aggressiveFee.Add(takerFee)
+			}
 
+			// The passive order is stored before the transfer, as the balance listener reallocates the owner's balance
+			// to the stored orders.
 			if passiveOrder.IsFilled() {
 				k.deleteOrder(ctx, passiveOrder)
-				types.EmitExpireEvent(ctx, *passiveOrder)
+			} else if passiveOrder.NeedsReplenishment() {
+				k.replenishOrder(ctx, passiveOrder)
 			} else {
 				k.setOrder(ctx, passiveOrder)
 			}
 
+			if err := k.transferTradedAmounts(ctx, nextDestinationFilledCoin, nextSourceFilledCoin, passiveOrder.Owner, aggressiveOrder.Owner, makerFee, takerFee); err != nil {
+				panic(err)
+			}
+
+			k.recordTrade(ctx, params, passiveOrder, aggressiveOrder.ID, nextSourceFilledCoin, nextDestinationFilledCoin)
+
+			types.EmitFillEvent(ctx, *passiveOrder, false, stepSourceFilled.RoundInt(), stepDestinationFilled.RoundInt(), makerFee)
+
+			if passiveOrder.IsFilled() {
+				types.EmitExpireEvent(ctx, *passiveOrder, types.ExpireReason_Filled)
+				k.recordOrder(ctx, *passiveOrder, types.OrderStatus_Filled)
+			}
+
 			// Register trades in market data
 			k.setMarketData(ctx, passiveOrder.Source.Denom, passiveOrder.Destination.Denom, passiveOrder.Price())
 			k.setMarketData(ctx, passiveOrder.Destination.Denom, passiveOrder.Source.Denom, sdk.NewDec(1).Quo(passiveOrder.Price()))
@@ -312,31 +531,44 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 			stepDestinationFilled = stepSourceFilled
 		}
 
-		types.EmitFillEvent(ctx, aggressiveOrder, true, aggressiveSourceFilled, aggressiveDestinationFilled)
+		types.EmitFillEvent(ctx, aggressiveOrder, true, aggressiveSourceFilled, aggressiveDestinationFilled, aggressiveFee)
+		k.setPriceBands(ctx, priceBands)
 
 		// Register trades in market data
 		k.setMarketData(ctx, aggressiveOrder.Source.Denom, aggressiveOrder.Destination.Denom, plan.Price)
 		k.setMarketData(ctx, aggressiveOrder.Destination.Denom, aggressiveOrder.Source.Denom, sdk.NewDec(1).Quo(plan.Price))
 
-		if aggressiveOrder.IsFilled() {
+		if isFilled() {
 			break
 		}
 	}
 
-	if aggressiveOrder.IsFilled() {
-		types.EmitExpireEvent(ctx, aggressiveOrder)
+	if isFilled() {
+		types.EmitExpireEvent(ctx, aggressiveOrder, types.ExpireReason_Filled)
+		k.recordOrder(ctx, aggressiveOrder, types.OrderStatus_Filled)
 	} else {
 		addToBook := true
 
-		switch aggressiveOrder.TimeInForce {
-		case types.TimeInForce_ImmediateOrCancel:
-			addToBook = false
-			types.EmitExpireEvent(ctx, aggressiveOrder)
-		case types.TimeInForce_FillOrKill:
+		// A fill-or-kill order is killed, even if self-trade prevention canceled its remainder.
+		switch {
+		case aggressiveOrder.TimeInForce == types.TimeInForce_FillOrKill:
 			KillOrder = true
 			addToBook = false
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
-			types.EmitExpireEvent(ctx, aggressiveOrder)
+			types.EmitExpireEvent(ctx, aggressiveOrder, types.ExpireReason_Killed)
+		case selfTradeCanceled:
+			addToBook = false
+			types.EmitExpireEvent(ctx, aggressiveOrder, types.ExpireReason_SelfTradeNewest)
+			k.recordOrder(ctx, aggressiveOrder, types.OrderStatus_Canceled)
+		case len(priceBandBreaches) > 0:
+			// The remainder would cross the spread if it was placed in the book.
+			addToBook = false
+			types.EmitExpireEvent(ctx, aggressiveOrder, types.ExpireReason_PriceBand)
+			k.recordOrder(ctx, aggressiveOrder, types.OrderStatus_Canceled)
+		case aggressiveOrder.TimeInForce == types.TimeInForce_ImmediateOrCancel:
+			addToBook = false
+			types.EmitExpireEvent(ctx, aggressiveOrder, types.ExpireReason_Killed)
+			k.recordOrder(ctx, aggressiveOrder, types.OrderStatus_Expired)
 		}
 
 		if addToBook {
@@ -348,6 +580,12 @@ func (k *Keeper) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) er
 		}
 	}
 
+	// Conditional orders triggered by this order are placed after it. Orders placed by a trigger leave any further
+	// triggers to the outermost order.
+	if !KillOrder && !isTriggeredOrder(ctx) {
+		k.triggerConditionalOrders(ctx, gasMeter, maxTriggeredOrdersPerOrder)
+	}
+
 	retEvManager.EmitEvents(ctx.EventManager().Events())
 
 	return nil
@@ -362,6 +600,7 @@ func (k Keeper) assetExists(ctx sdk.Context, asset sdk.Coin) bool {
 func (k *Keeper) CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error {
 	// Use a fixed gas amount
 	ctx.GasMeter().ConsumeGas(gasPriceCancelReplaceOrder, "CancelReplaceOrder")
+	gasMeter := ctx.GasMeter()
 	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
 
 	origOrder := k.GetOrderByOwnerAndClientOrderId(ctx, newOrder.Owner, origClientOrderId)
@@ -394,16 +633,49 @@ func (k *Keeper) CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order,
 	}
 
 	k.deleteOrder(ctx, origOrder)
-	types.EmitExpireEvent(ctx, *origOrder)
+	types.EmitExpireEvent(ctx, *origOrder, types.ExpireReason_Replaced)
+	k.recordOrder(ctx, *origOrder, types.OrderStatus_Canceled)
 
 	// Adjust remaining according to how much of the replaced order was filled:
 	newOrder.SourceFilled = origOrder.SourceFilled
 	newOrder.SourceRemaining = newOrder.Source.Amount.Sub(newOrder.SourceFilled)
 	newOrder.DestinationFilled = origOrder.DestinationFilled
 
-	newOrder.TimeInForce = origOrder.TimeInForce
+	// The time in force cannot be changed, but an expiring order can be given a new expiry.
+	if newOrder.TimeInForce != origOrder.TimeInForce {
+		newOrder.TimeInForce = origOrder.TimeInForce
+		newOrder.GoodTillTime = origOrder.GoodTillTime
+		newOrder.GoodTillBlock = origOrder.GoodTillBlock
+	}
+
+	// The replacement is covered by the fixed price of the cancel-replace, but the orders it triggers are not.
+	return k.newOrderSingle(ctx, gasMeter, newOrder, false)
+}
+
+// applyPostOnly rejects or re-prices a post-only order that would cross the spread. A re-priced order keeps its source
+// amount and asks for the smallest destination amount that does not match the best opposite price and is priced at a
+// multiple of the tick size.
+func (k *Keeper) applyPostOnly(ctx sdk.Context, params types.Params, order *types.Order) error {
+	plan := k.createExecutionPlan(ctx, order.Destination.Denom, order.Source.Denom)
+	if len(plan.Orders) == 0 || order.Price().GT(plan.Price) {
+		return nil
+	}
+
+	if order.PostOnly == types.PostOnlyMode_Reject {
+		return sdkerrors.Wrapf(types.ErrPostOnlyWouldMatch, "Order price %v does not exceed best price %v", order.Price(), plan.Price)
+	}
+
+	// Prices are rounded to 18 decimals, so the first amount above the best price may not yet compare as such.
+	order.Destination.Amount = plan.Price.MulInt(order.Source.Amount).TruncateInt()
+	for !order.Price().GT(plan.Price) {
+		order.Destination.Amount = order.Destination.Amount.AddRaw(1)
+	}
 
-	return k.NewOrderSingle(ctx, newOrder)
+	// Raising the price further to the next tick keeps it above the best price.
+	config := k.instrumentConfig(ctx, params, order.Source.Denom, order.Destination.Denom)
+	order.Destination.Amount = config.RoundUpToTick(order.Source.Amount, order.Destination.Amount)
+
+	return nil
 }
 
 func (k *Keeper) GetOrderByOwnerAndClientOrderId(ctx sdk.Context, owner, clientOrderId string) *types.Order {
@@ -425,6 +697,23 @@ func (k *Keeper) GetOrderByOwnerAndClientOrderId(ctx sdk.Context, owner, clientO
 	return o
 }
 
+// GetOrderByID returns the active order with the given order ID, or nil if there is none.
+func (k *Keeper) GetOrderByID(ctx sdk.Context, orderId uint64) *types.Order {
+	ownerKey := ctx.KVStore(k.keyIndices).Get(types.GetOrderIDKey(orderId))
+	if ownerKey == nil {
+		return nil
+	}
+
+	bz := ctx.KVStore(k.key).Get(ownerKey)
+	if bz == nil {
+		return nil
+	}
+
+	o := &types.Order{}
+	k.cdc.MustUnmarshal(bz, o)
+	return o
+}
+
 func (k *Keeper) CancelOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) error {
 	// Use a fixed gas amount
 	ctx.GasMeter().ConsumeGas(gasPriceCancelOrder, "CancelOrder")
@@ -434,36 +723,72 @@ func (k *Keeper) CancelOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderI
 	order := k.GetOrderByOwnerAndClientOrderId(ctx, owner.String(), clientOrderId)
 
 	if order == nil {
+		if k.cancelConditionalOrder(ctx, owner.String(), clientOrderId) {
+			return nil
+		}
+
 		return sdkerrors.Wrap(types.ErrClientOrderIdNotFound, clientOrderId)
 	}
 
-	types.EmitExpireEvent(ctx, *order)
+	types.EmitExpireEvent(ctx, *order, types.ExpireReason_Cancelled)
 	k.deleteOrder(ctx, order)
+	k.recordOrder(ctx, *order, types.OrderStatus_Canceled)
 
 	return nil
 }
 
-// Update any orders that can no longer be filled with the account's balance.
-func (k *Keeper) accountChanged(ctx sdk.Context, accounts []sdk.AccAddress) {
-	for _, acc := range accounts {
-		orders := k.GetOrdersByOwner(ctx, acc)
-		for _, order := range orders {
-			spendableCoins := k.bk.SpendableCoins(ctx, acc)
-			denomBalance := spendableCoins.AmountOf(order.Source.Denom)
-
-			origSourceRemaining := order.SourceRemaining
-			order.SourceRemaining = order.Source.Amount.Sub(order.SourceFilled)
-			order.SourceRemaining = sdk.MinInt(order.SourceRemaining, denomBalance)
-
-			if order.SourceRemaining.IsZero() {
-				types.EmitExpireEvent(ctx, *order)
-				k.deleteOrder(ctx, order)
-			} else if !origSourceRemaining.Equal(order.SourceRemaining) {
-				types.EmitUpdateEvent(ctx, *order)
-				k.setOrder(ctx, order)
-			}
+// CancelAllOrders cancels the orders of owner, optionally limited to the src/dst instrument, and returns the number of
+// orders canceled. At most types.MaxCancelAllOrders orders are canceled.
+func (k *Keeper) CancelAllOrders(ctx sdk.Context, owner sdk.AccAddress, src, dst string) uint32 {
+	ctx.GasMeter().ConsumeGas(gasPriceCancelAllOrders, "CancelAllOrders")
+	gasMeter := ctx.GasMeter()
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	orders := k.getCancelableOrders(ctx, gasMeter, owner, src, dst)
+	for _, order := range orders {
+		types.EmitExpireEvent(ctx, *order, types.ExpireReason_Cancelled)
+		k.deleteOrder(ctx, order)
+		k.recordOrder(ctx, *order, types.OrderStatus_Canceled)
+	}
+
+	return uint32(len(orders))
+}
+
+// getCancelableOrders returns up to types.MaxCancelAllOrders orders of owner, limited to the src/dst instrument if src
+// is set. The orders of an instrument are found through the owner and source denomination index. Every order examined
+// is charged to gasMeter.
+func (k Keeper) getCancelableOrders(ctx sdk.Context, gasMeter sdk.GasMeter, owner sdk.AccAddress, src, dst string) (res []*types.Order) {
+	var (
+		store    = ctx.KVStore(k.key)
+		idxStore = ctx.KVStore(k.keyIndices)
+		it       sdk.Iterator
+	)
+
+	if src == "" {
+		it = sdk.KVStorePrefixIterator(store, types.GetOwnerKey(owner.String(), ""))
+	} else {
+		it = sdk.KVStorePrefixIterator(idxStore, types.GetOwnerDenomKeyPrefix(owner.String(), src))
+	}
+	defer it.Close()
+
+	for ; it.Valid() && len(res) < types.MaxCancelAllOrders; it.Next() {
+		gasMeter.ConsumeGas(gasPriceCancelAllOrdersPerOrder, "CancelAllOrders")
+
+		bz := it.Value()
+		if src != "" {
+			bz = store.Get(bz)
+		}
+
+		o := &types.Order{}
+		k.cdc.MustUnmarshal(bz, o)
+		if src != "" && o.Destination.Denom != dst {
+			continue
 		}
+
+		res = append(res, o)
 	}
+
+	return
 }
 
 func (k Keeper) setOrder(ctx sdk.Context, order *types.Order) {
@@ -477,8 +802,25 @@ func (k Keeper) setOrder(ctx sdk.Context, order *types.Order) {
 	ownerKey := types.GetOwnerKey(order.Owner, order.ClientOrderID)
 	store.Set(ownerKey, orderbz)
 
-	priorityKey := types.GetPriorityKey(order.Source.Denom, order.Destination.Denom, order.Price(), order.ID)
+	priorityKey := types.GetPriorityKey(order.Source.Denom, order.Destination.Denom, order.Price(), order.PriorityID())
 	idxStore.Set(priorityKey, orderbz)
+	idxStore.Set(types.GetOrderIDKey(order.ID), ownerKey)
+	idxStore.Set(types.GetOwnerDenomKey(order.Owner, order.Source.Denom, order.ClientOrderID), ownerKey)
+
+	if expiryKey := types.GetExpiryKey(order); expiryKey != nil {
+		idxStore.Set(expiryKey, ownerKey)
+	}
+}
+
+// replenishOrder displays a new slice of an iceberg order, which loses its priority to the orders already in the book.
+func (k Keeper) replenishOrder(ctx sdk.Context, order *types.Order) {
+	idxStore := ctx.KVStore(k.keyIndices)
+	idxStore.Delete(types.GetPriorityKey(order.Source.Denom, order.Destination.Denom, order.Price(), order.PriorityID()))
+
+	displayRemaining := *order.DisplaySize
+	order.DisplayRemaining = &displayRemaining
+	order.Priority = k.getNextOrderNumber(ctx)
+	k.setOrder(ctx, order)
 }
 
 func (k Keeper) GetInstrument(ctx sdk.Context, src, dst string) *types.MarketData {
@@ -568,8 +910,14 @@ func (k *Keeper) deleteOrder(ctx sdk.Context, order *types.Order) {
 	ownerKey := types.GetOwnerKey(order.Owner, order.ClientOrderID)
 	store.Delete(ownerKey)
 
-	priorityKey := types.GetPriorityKey(order.Source.Denom, order.Destination.Denom, order.Price(), order.ID)
+	priorityKey := types.GetPriorityKey(order.Source.Denom, order.Destination.Denom, order.Price(), order.PriorityID())
 	idxStore.Delete(priorityKey)
+	idxStore.Delete(types.GetOrderIDKey(order.ID))
+	idxStore.Delete(types.GetOwnerDenomKey(order.Owner, order.Source.Denom, order.ClientOrderID))
+
+	if expiryKey := types.GetExpiryKey(order); expiryKey != nil {
+		idxStore.Delete(expiryKey)
+	}
 }
 
 func (k Keeper) getBestOrder(ctx sdk.Context, src, dst string) *types.Order {
@@ -588,6 +936,41 @@ func (k Keeper) getBestOrder(ctx sdk.Context, src, dst string) *types.Order {
 	return nil
 }
 
+// GetDepth aggregates the passive orders of an instrument into at most maxLevels
+// price levels, sorted from the best to the worst price.
+func (k Keeper) GetDepth(ctx sdk.Context, src, dst string, maxLevels int) []types.PriceLevel {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	it := sdk.KVStorePrefixIterator(idxStore, types.GetPriorityKeyByInstrument(src, dst))
+	defer it.Close()
+
+	levels := make([]types.PriceLevel, 0)
+	for ; it.Valid(); it.Next() {
+		order := new(types.Order)
+		k.cdc.MustUnmarshal(it.Value(), order)
+
+		// Orders are sorted by price, so orders with the same price are adjacent.
+		price := order.Price()
+		if n := len(levels); n > 0 && levels[n-1].Price.Equal(price) {
+			levels[n-1].SourceRemaining = levels[n-1].SourceRemaining.Add(order.VisibleRemaining())
+			levels[n-1].OrderCount++
+			continue
+		}
+
+		if len(levels) == maxLevels {
+			break
+		}
+
+		levels = append(levels, types.PriceLevel{
+			Price:           price,
+			SourceRemaining: order.VisibleRemaining(),
+			OrderCount:      1,
+		})
+	}
+
+	return levels
+}
+
 // GetBestPrice returns the best priced passive order for source and
 // destination instruments. Returns nil when executePlan cannot find a best
 // plan.
@@ -622,6 +1005,56 @@ func (k Keeper) GetOrdersByOwner(ctx sdk.Context, owner sdk.AccAddress) (res []*
 	return
 }
 
+// getOrdersByOwnerAndDenom returns the resting orders of the owner with the given source denomination, sorted by client
+// order id.
+func (k Keeper) getOrdersByOwnerAndDenom(ctx sdk.Context, owner sdk.AccAddress, src string) (res []*types.Order) {
+	var (
+		store    = ctx.KVStore(k.key)
+		idxStore = ctx.KVStore(k.keyIndices)
+	)
+
+	it := sdk.KVStorePrefixIterator(idxStore, types.GetOwnerDenomKeyPrefix(owner.String(), src))
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		o := &types.Order{}
+		k.cdc.MustUnmarshal(store.Get(it.Value()), o)
+		res = append(res, o)
+	}
+
+	return
+}
+
+// countOrdersByOwnerAndDenom counts the resting orders of the owner with the given source denomination, up to max.
+func (k Keeper) countOrdersByOwnerAndDenom(ctx sdk.Context, owner sdk.AccAddress, src string, max int) (count int) {
+	idxStore := ctx.KVStore(k.keyIndices)
+
+	it := sdk.KVStorePrefixIterator(idxStore, types.GetOwnerDenomKeyPrefix(owner.String(), src))
+	defer it.Close()
+
+	for ; it.Valid() && count < max; it.Next() {
+		count++
+	}
+
+	return
+}
+
+// GetAllOrders returns every resting order, sorted by owner and client order id.
+func (k Keeper) GetAllOrders(ctx sdk.Context) (res []*types.Order) {
+	store := ctx.KVStore(k.key)
+
+	it := sdk.KVStorePrefixIterator(store, types.GetOwnersPrefix())
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		o := &types.Order{}
+		k.cdc.MustUnmarshal(it.Value(), o)
+		res = append(res, o)
+	}
+
+	return
+}
+
 func containsClientId(orders []*types.Order, clientOrderId string) bool {
 	// TODO Orders are already ordered by ClientOrderId. Consider using a binary search.
 	for _, order := range orders {
@@ -645,33 +1078,51 @@ func getOrdersSourceDemand(orders []*types.Order, src, dst string) sdk.Coin {
 	return sdk.NewCoin(src, sumSourceRemaining)
 }
 
-func (k Keeper) transferTradedAmounts(ctx sdk.Context, sourceFilled, destinationFilled sdk.Coin, passiveAccountAddr, aggressiveAccountAddr string) error {
+// transferTradedAmounts settles a fill, where the passive account pays makerFee of the sourceFilled it receives and the
+// aggressive account pays takerFee of the destinationFilled it receives to the fee recipient.
+func (k Keeper) transferTradedAmounts(ctx sdk.Context, sourceFilled, destinationFilled sdk.Coin, passiveAccountAddr, aggressiveAccountAddr string, makerFee, takerFee sdk.Int) error {
 	inputs := []banktypes.Input{
 		{Address: aggressiveAccountAddr, Coins: sdk.NewCoins(sourceFilled)},
 		{Address: passiveAccountAddr, Coins: sdk.NewCoins(destinationFilled)},
 	}
 
 	outputs := []banktypes.Output{
-		{Address: aggressiveAccountAddr, Coins: sdk.NewCoins(destinationFilled)},
-		{Address: passiveAccountAddr, Coins: sdk.NewCoins(sourceFilled)},
+		{Address: aggressiveAccountAddr, Coins: sdk.NewCoins(destinationFilled.SubAmount(takerFee))},
+		{Address: passiveAccountAddr, Coins: sdk.NewCoins(sourceFilled.SubAmount(makerFee))},
+	}
+
+	fees := sdk.NewCoins(
+		sdk.NewCoin(sourceFilled.Denom, makerFee),
+		sdk.NewCoin(destinationFilled.Denom, takerFee),
+	)
+	if !fees.IsZero() {
+		feeRecipient := k.ak.GetModuleAccount(ctx, k.feeRecipient).GetAddress()
+		outputs = append(outputs, banktypes.Output{Address: feeRecipient.String(), Coins: fees})
 	}
 
 	return k.bk.InputOutputCoins(ctx, inputs, outputs)
 }
 
 func (k Keeper) getNextOrderNumber(ctx sdk.Context) uint64 {
-	var orderID uint64
+	orderID := k.peekNextOrderNumber(ctx)
+	k.setNextOrderNumber(ctx, orderID+1)
+	return orderID
+}
+
+// peekNextOrderNumber returns the order ID that will be assigned to the next order without consuming it.
+func (k Keeper) peekNextOrderNumber(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.key)
 	bz := store.Get(types.GetOrderIDGeneratorKey())
 	if bz == nil {
-		orderID = 0
-	} else {
-		orderID = sdk.BigEndianToUint64(bz)
+		return 0
 	}
 
-	bz = sdk.Uint64ToBigEndian(orderID + 1)
-	store.Set(types.GetOrderIDGeneratorKey(), bz)
-	return orderID
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setNextOrderNumber(ctx sdk.Context, orderID uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.GetOrderIDGeneratorKey(), sdk.Uint64ToBigEndian(orderID))
 }
 
 func (k Keeper) registerMarketData(ctx sdk.Context, src, dst string) {
@@ -702,4 +1153,6 @@ func (k Keeper) setMarketData(ctx sdk.Context, src, dst string, price sdk.Dec) {
 
 	bz := k.cdc.MustMarshal(&md)
 	idxStore.Set(key, bz)
+
+	k.recordTriggers(ctx, src, dst, price)
 }
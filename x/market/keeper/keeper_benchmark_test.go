@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+// BenchmarkAccountChanged measures incoming transfers to a market maker with orders in many denominations. The
+// cost of a transfer depends on the orders of the transferred denomination only.
+func BenchmarkAccountChanged(b *testing.B) {
+	const ordersPerDenom = 10
+
+	for _, denoms := range []int{1, 10, 100} {
+		for _, transferred := range []string{"den0", "usd"} {
+			b.Run(fmt.Sprintf("denoms=%d/transfer=%v", denoms, transferred), func(b *testing.B) {
+				ctx, k, ak, bk := createTestComponents(b)
+
+				balance := sdk.NewCoins(sdk.NewCoin("usd", sdk.NewInt(1000000)))
+				for i := 0; i < denoms; i++ {
+					balance = balance.Add(sdk.NewCoin(fmt.Sprintf("den%d", i), sdk.NewInt(1000000)))
+				}
+				maker := createAccount(ctx, ak, bk, randomAddress(), balance.String())
+				funder := createAccount(ctx, ak, bk, randomAddress(), balance.String())
+
+				for i := 0; i < denoms; i++ {
+					for j := 0; j < ordersPerDenom; j++ {
+						o := order(ctx.BlockTime(), maker, fmt.Sprintf("%dden%d", 100+j, i), fmt.Sprintf("%dusd", 1000+j))
+						require.NoError(b, k.NewOrderSingle(ctx, o))
+					}
+				}
+
+				amt := coins("1" + transferred)
+				ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if err := bk.SendCoins(ctx, funder.GetAddress(), maker.GetAddress(), amt); err != nil {
+						b.Fatal(err)
+					}
+				}
+				b.StopTimer()
+
+				b.ReportMetric(float64(ctx.GasMeter().GasConsumed())/float64(b.N), "gas/op")
+			})
+		}
+	}
+}
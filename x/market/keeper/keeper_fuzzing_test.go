@@ -49,6 +49,7 @@ func TestFuzzing1(t *testing.T) {
 		acc1 = createAccount(ctx, ak, bk, randomAddress(), "1000000000eur")
 		acc2 = createAccount(ctx, ak, bk, randomAddress(), "1000000000usd")
 		acc3 = createAccount(ctx, ak, bk, randomAddress(), "1000000000chf")
+		acc4 = createAccount(ctx, ak, bk, randomAddress(), "1000000000gbp")
 	)
 
 	totalSupply := snapshotAccounts(ctx, bk)
@@ -63,6 +64,14 @@ func TestFuzzing1(t *testing.T) {
 		basePriceUSDCHF = sdk.NewDecWithPrec(r.Int63n(1000), 2)
 	}
 
+	basePriceCHFGBP := sdk.ZeroDec()
+	for basePriceCHFGBP.IsZero() {
+		basePriceCHFGBP = sdk.NewDecWithPrec(r.Int63n(1000), 2)
+	}
+
+	// GBP only trades against CHF and EUR, so EUR/GBP can also be filled through USD and CHF.
+	basePriceEURGBP := basepriceEURUSD.Mul(basePriceUSDCHF).Mul(basePriceCHFGBP)
+
 	ONE := sdk.OneDec()
 	testdata := []struct {
 		src, dst string
@@ -75,6 +84,10 @@ func TestFuzzing1(t *testing.T) {
 		{"chf", "usd", ONE.Quo(basePriceUSDCHF), acc3},
 		{"eur", "chf", basepriceEURUSD.Mul(basePriceUSDCHF), acc1},
 		{"chf", "eur", ONE.Quo(basepriceEURUSD.Mul(basePriceUSDCHF)), acc3},
+		{"chf", "gbp", basePriceCHFGBP, acc3},
+		{"gbp", "chf", ONE.Quo(basePriceCHFGBP), acc4},
+		{"eur", "gbp", basePriceEURGBP, acc1},
+		{"gbp", "eur", ONE.Quo(basePriceEURGBP), acc4},
 	}
 
 	allOrders := make([]types.Order, 0)
@@ -100,6 +113,9 @@ func TestFuzzing1(t *testing.T) {
 
 	// dumpEvents(ctx.EventManager().ABCIEvents())
 	require.True(t, totalSupply.Sub(snapshotAccounts(ctx, bk)).IsZero())
+
+	msg, broken := AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
 }
 
 func generateOrders(
@@ -33,6 +33,7 @@ import (
 	embank "github.com/e-money/em-ledger/hooks/bank"
 	emtypes "github.com/e-money/em-ledger/types"
 	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/libs/log"
@@ -519,6 +520,72 @@ func TestGetSrcFromSlippage(t *testing.T) {
 	)
 }
 
+func TestGetDstFromSlippage(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "500chf")
+
+	// Pairs that have never traded are priced from the book
+	_, err := k.GetDstFromSlippage(ctx, sdk.NewCoin("eur", sdk.NewInt(100)), "chf", sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrNoLiquidity)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100chf", "80eur")))
+
+	_, err = k.GetDstFromSlippage(ctx, sdk.NewCoin("eur", sdk.NewInt(100)), "chf", sdk.OneDec())
+	require.ErrorIs(t, err, types.ErrInvalidSlippage)
+	_, err = k.GetDstFromSlippage(ctx, sdk.NewCoin("eur", sdk.NewInt(100)), "chf", sdk.NewDec(-1))
+	require.ErrorIs(t, err, types.ErrInvalidSlippage)
+
+	dst, err := k.GetDstFromSlippage(ctx, sdk.NewCoin("eur", sdk.NewInt(100)), "chf", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Equal(t, "125chf", dst.String())
+
+	dst, err = k.GetDstFromSlippage(ctx, sdk.NewCoin("eur", sdk.NewInt(100)), "chf", sdk.NewDecWithPrec(2, 1))
+	require.NoError(t, err)
+	require.Equal(t, "100chf", dst.String())
+}
+
+func TestNewSourceMarketOrder(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "500eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "500chf")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "50chf", "50eur")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "50chf", "55eur")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "50chf", "100eur")))
+
+	sourceMarketOrder := func(tif types.TimeInForce, src string) types.Order {
+		o := order(ctx.BlockTime(), acc1, src, "1chf")
+		o.TimeInForce = tif
+
+		dst, err := k.GetDstFromSlippage(ctx, o.Source, "chf", sdk.NewDecWithPrec(1, 1))
+		require.NoError(t, err)
+		o.Destination = dst
+		return o
+	}
+
+	require.ErrorIs(t, k.NewSourceMarketOrder(ctx, sourceMarketOrder(types.TimeInForce_GoodTillCancel, "100eur")), types.ErrUnknownTimeInForce)
+
+	// The order cannot be filled within the slippage and is killed
+	require.NoError(t, k.NewSourceMarketOrder(ctx, sourceMarketOrder(types.TimeInForce_FillOrKill, "200eur")))
+	require.Equal(t, "500eur", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 3)
+
+	// The entire source is sold, including at prices below the best price, but within the slippage
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.NewSourceMarketOrder(ctx, sourceMarketOrder(types.TimeInForce_ImmediateOrCancel, "100eur")))
+	require.Equal(t, "95chf,400eur", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	require.Len(t, filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyReason, types.ExpireReason_Filled.AttributeValue()), 2)
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+
+	var remaining []string
+	for _, o := range k.GetOrdersByOwner(ctx, acc2.GetAddress()) {
+		remaining = append(remaining, o.SourceRemaining.String())
+	}
+	require.ElementsMatch(t, []string{"5", "50"}, remaining)
+}
+
 func TestFillOrKillMarketOrder1(t *testing.T) {
 	ctx, k, ak, bk := createTestComponents(t)
 
@@ -698,6 +765,43 @@ func TestCancelZeroRemainingOrders(t *testing.T) {
 	require.Len(t, orders, 0)
 }
 
+func TestAccountChangedByDenomination(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc := createAccount(ctx, ak, bk, randomAddress(), "1000eur,1000chf")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "1000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "600eur", "700usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "300eur", "400gbp")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc, "500chf", "600usd")))
+
+	remaining := func(denom string) (res []string) {
+		for _, o := range k.getOrdersByOwnerAndDenom(ctx, acc.GetAddress(), denom) {
+			res = append(res, o.SourceRemaining.String())
+		}
+		return
+	}
+
+	// Transfers that bypass the listeners leave the orders as they were
+	require.NoError(t, (*bk.GetBankKeeper()).SendCoins(ctx, acc.GetAddress(), acc2.GetAddress(), coins("800chf")))
+	require.Equal(t, []string{"500"}, remaining("chf"))
+
+	// Only the orders of the transferred denomination are re-evaluated
+	require.NoError(t, bk.SendCoins(ctx, acc2.GetAddress(), acc.GetAddress(), coins("10usd")))
+	require.ElementsMatch(t, []string{"600", "300"}, remaining("eur"))
+	require.Equal(t, []string{"500"}, remaining("chf"))
+
+	require.NoError(t, bk.SendCoins(ctx, acc.GetAddress(), acc2.GetAddress(), coins("500eur")))
+	require.ElementsMatch(t, []string{"500", "300"}, remaining("eur"))
+	require.Equal(t, []string{"500"}, remaining("chf"))
+
+	k.accountChanged(ctx, []sdk.AccAddress{acc.GetAddress()}, []string{"chf"})
+	require.Equal(t, []string{"200"}, remaining("chf"))
+
+	msg, broken := OrderIndicesInvariant(k)(ctx)
+	require.False(t, broken, msg)
+}
+
 func TestInsufficientBalance1(t *testing.T) {
 	ctx, k, ak, bk := createTestComponents(t)
 
@@ -727,6 +831,50 @@ func TestInsufficientBalance1(t *testing.T) {
 	require.True(t, totalSupply.Sub(snapshotAccounts(ctx, bk)).IsZero())
 }
 
+func TestBalanceAllocatedPerInstrument(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "500eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd,5000chf")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "200eur", "240usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "200eur", "250usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "300eur", "400chf")))
+
+	// The remaining balance is shared by the orders of each instrument
+	require.NoError(t, bk.SendCoins(ctx, acc1.GetAddress(), acc2.GetAddress(), coins("250eur")))
+
+	demand := make(map[string]sdk.Int)
+	for _, o := range k.GetOrdersByOwner(ctx, acc1.GetAddress()) {
+		if _, found := demand[o.Destination.Denom]; !found {
+			demand[o.Destination.Denom] = sdk.ZeroInt()
+		}
+		demand[o.Destination.Denom] = demand[o.Destination.Denom].Add(o.SourceRemaining)
+	}
+	require.Equal(t, sdk.NewInt(250), demand["usd"])
+	require.Equal(t, sdk.NewInt(250), demand["chf"])
+}
+
+func TestFilledPassiveOrderNotAllocatedBalance(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "500eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	// The orders reserve the full balance, so the filled order must not be allocated any of it during settlement
+	o1 := order(ctx.BlockTime(), acc1, "200eur", "240usd")
+	o2 := order(ctx.BlockTime(), acc1, "300eur", "390usd")
+	o1.ClientOrderID, o2.ClientOrderID = "a", "b"
+	require.NoError(t, k.NewOrderSingle(ctx, o1))
+	require.NoError(t, k.NewOrderSingle(ctx, o2))
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "240usd", "200eur")))
+
+	orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 1)
+	require.Equal(t, sdk.NewInt(300), orders[0].SourceRemaining)
+}
+
 func Test2(t *testing.T) {
 	ctx, k, ak, bk := createTestComponents(t)
 
@@ -907,6 +1055,357 @@ func TestCancelOrders1(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestExpireReasons(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	t0 := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(t0)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur,100chf")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	filled := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+	canceled := order(ctx.BlockTime(), acc1, "100eur", "130usd")
+	replaced := order(ctx.BlockTime(), acc1, "100eur", "150usd")
+	unfunded := order(ctx.BlockTime(), acc1, "100chf", "120usd")
+	gtt := order(ctx.BlockTime(), acc1, "100eur", "200usd")
+	gtt.TimeInForce = types.TimeInForce_GoodTillTime
+	expiry := t0.Add(time.Hour)
+	gtt.GoodTillTime = &expiry
+	for _, o := range []types.Order{filled, canceled, replaced, unfunded, gtt} {
+		require.NoError(t, k.NewOrderSingle(ctx, o))
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	require.NoError(t, k.CancelOrder(ctx, acc1.GetAddress(), canceled.ClientOrderID))
+
+	replacement := order(ctx.BlockTime(), acc1, "100eur", "160usd")
+	require.NoError(t, k.CancelReplaceLimitOrder(ctx, replacement, replaced.ClientOrderID))
+
+	ioc := order(ctx.BlockTime(), acc2, "240usd", "200eur")
+	ioc.TimeInForce = types.TimeInForce_ImmediateOrCancel
+	require.NoError(t, k.NewOrderSingle(ctx, ioc))
+
+	require.NoError(t, bk.SendCoins(ctx, acc1.GetAddress(), acc2.GetAddress(), coins("100chf")))
+	k.ExpireTimedOrders(ctx.WithBlockTime(expiry))
+
+	expected := []struct {
+		clientOrderID string
+		reason        types.ExpireReason
+	}{
+		{canceled.ClientOrderID, types.ExpireReason_Cancelled},
+		{replaced.ClientOrderID, types.ExpireReason_Replaced},
+		{filled.ClientOrderID, types.ExpireReason_Filled},
+		{ioc.ClientOrderID, types.ExpireReason_Killed},
+		{unfunded.ClientOrderID, types.ExpireReason_InsufficientBalance},
+		{gtt.ClientOrderID, types.ExpireReason_Expired},
+	}
+
+	legacyEvents := filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "expire")
+	require.Len(t, legacyEvents, len(expected))
+
+	var typedEvents []*types.EventOrderExpired
+	for _, ev := range ctx.EventManager().ABCIEvents() {
+		if ev.Type != proto.MessageName(&types.EventOrderExpired{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(ev)
+		require.NoError(t, err)
+		typedEvents = append(typedEvents, msg.(*types.EventOrderExpired))
+	}
+	require.Len(t, typedEvents, len(expected))
+
+	for i, exp := range expected {
+		cid, _ := getEventAttrValue(legacyEvents[i], types.AttributeKeyClientOrderID)
+		require.Equal(t, exp.clientOrderID, cid)
+		reason, _ := getEventAttrValue(legacyEvents[i], types.AttributeKeyReason)
+		require.Equal(t, exp.reason.AttributeValue(), reason)
+
+		require.Equal(t, exp.clientOrderID, typedEvents[i].ClientOrderID)
+		require.Equal(t, exp.reason, typedEvents[i].Reason)
+	}
+
+	// The typed event states the final state of the order like the legacy event
+	require.Equal(t, coin("100eur"), typedEvents[2].SourceFilled)
+	require.Equal(t, coin("0eur"), typedEvents[2].SourceRemaining)
+	require.Equal(t, coin("120usd"), typedEvents[2].DestinationFilled)
+}
+
+func TestKeeperCancelAllOrders(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur,5000usd")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+
+	for _, o := range []types.Order{
+		order(ctx.BlockTime(), acc1, "100eur", "120usd"),
+		order(ctx.BlockTime(), acc1, "100eur", "130usd"),
+		order(ctx.BlockTime(), acc1, "100eur", "100chf"),
+		order(ctx.BlockTime(), acc1, "100usd", "120eur"),
+		order(ctx.BlockTime(), acc2, "100eur", "120usd"),
+	} {
+		require.NoError(t, k.NewOrderSingle(ctx, o))
+	}
+
+	// Only orders of the instrument are canceled, but gas is charged for all orders of the account selling the source
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	canceled := k.CancelAllOrders(ctx, acc1.GetAddress(), "eur", "usd")
+	require.Equal(t, uint32(2), canceled)
+	require.Equal(t, gasPriceCancelAllOrders+3*gasPriceCancelAllOrdersPerOrder, ctx.GasMeter().GasConsumed())
+	require.Len(t, filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "expire"), 2)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 2)
+
+	canceled = k.CancelAllOrders(ctx, acc1.GetAddress(), "", "")
+	require.Equal(t, uint32(2), canceled)
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 1)
+	require.Equal(t, []types.PriceLevel{}, k.GetDepth(ctx, "usd", "eur", 10))
+
+	canceled = k.CancelAllOrders(ctx, acc1.GetAddress(), "", "")
+	require.Zero(t, canceled)
+
+	// The number of orders canceled per call is bounded
+	for i := 0; i < types.MaxCancelAllOrders+1; i++ {
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "1eur", "100usd")))
+	}
+
+	canceled = k.CancelAllOrders(ctx, acc1.GetAddress(), "", "")
+	require.Equal(t, uint32(types.MaxCancelAllOrders), canceled)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+}
+
+func TestPostOnly(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+
+	postOnly := func(src, dst string, mode types.PostOnlyMode) types.Order {
+		o := order(ctx.BlockTime(), acc2, src, dst)
+		o.PostOnly = mode
+		return o
+	}
+
+	// Crossing orders are rejected
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	err := k.NewOrderSingle(ctx, postOnly("120usd", "100eur", types.PostOnlyMode_Reject))
+	require.ErrorIs(t, err, types.ErrPostOnlyWouldMatch)
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+	require.Empty(t, ctx.EventManager().Events())
+	require.Equal(t, "5000usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+
+	// Orders that do not cross rest in the book
+	require.NoError(t, k.NewOrderSingle(ctx, postOnly("120usd", "101eur", types.PostOnlyMode_Reject)))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 1)
+
+	// Crossing orders can be re-priced to rest just behind the best price
+	o := postOnly("120usd", "90eur", types.PostOnlyMode_Reprice)
+	require.NoError(t, k.NewOrderSingle(ctx, o))
+	require.Equal(t, "5000usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+
+	repriced := k.GetOrderByOwnerAndClientOrderId(ctx, acc2.GetAddress().String(), o.ClientOrderID)
+	require.NotNil(t, repriced)
+	require.Equal(t, coin("120usd"), repriced.Source)
+	require.Equal(t, coin("101eur"), repriced.Destination)
+	require.Equal(t, types.PostOnlyMode_Reprice, repriced.PostOnly)
+
+	// Replacing orders are subject to the same check
+	replacement := postOnly("120usd", "99eur", types.PostOnlyMode_Reject)
+	err = k.CancelReplaceLimitOrder(ctx, replacement, o.ClientOrderID)
+	require.ErrorIs(t, err, types.ErrPostOnlyWouldMatch)
+
+	// Post-only orders cannot be immediate
+	o = postOnly("120usd", "200eur", types.PostOnlyMode_Reject)
+	o.TimeInForce = types.TimeInForce_ImmediateOrCancel
+	require.ErrorIs(t, k.NewOrderSingle(ctx, o), types.ErrInvalidPostOnly)
+}
+
+func TestSelfTradePrevention(t *testing.T) {
+	setup := func() (sdk.Context, *Keeper, authtypes.AccountI, authtypes.AccountI, types.Order) {
+		ctx, k, ak, bk := createTestComponents(t)
+
+		acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur,5000usd")
+		acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+
+		own := order(ctx.BlockTime(), acc1, "100eur", "120usd")
+		require.NoError(t, k.NewOrderSingle(ctx, own))
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100eur", "125usd")))
+
+		return ctx.WithEventManager(sdk.NewEventManager()), k, acc1, acc2, own
+	}
+
+	selfTradeExpiries := func(ctx sdk.Context, reason types.ExpireReason) []abci.Event {
+		return filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyReason, reason.AttributeValue())
+	}
+
+	// Without self-trade prevention, orders match the orders of the same owner
+	ctx, k, acc1, _, _ := setup()
+	aggressive := order(ctx.BlockTime(), acc1, "120usd", "100eur")
+	require.NoError(t, k.NewOrderSingle(ctx, aggressive))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+
+	// The aggressive order is canceled and the resting order is kept
+	ctx, k, acc1, _, own := setup()
+	aggressive = order(ctx.BlockTime(), acc1, "125usd", "100eur")
+	aggressive.SelfTradePrevention = types.SelfTradePrevention_CancelNewest
+	require.NoError(t, k.NewOrderSingle(ctx, aggressive))
+
+	orders := k.GetOrdersByOwner(ctx, acc1.GetAddress())
+	require.Len(t, orders, 1)
+	require.Equal(t, own.ClientOrderID, orders[0].ClientOrderID)
+	require.True(t, orders[0].SourceFilled.IsZero())
+
+	expired := selfTradeExpiries(ctx, types.ExpireReason_SelfTradeNewest)
+	require.Len(t, expired, 1)
+	cid, _ := getEventAttrValue(expired[0], types.AttributeKeyClientOrderID)
+	require.Equal(t, aggressive.ClientOrderID, cid)
+
+	history, _, err := k.GetOrderHistory(ctx, acc1.GetAddress(), nil)
+	require.NoError(t, err)
+	require.Len(t, history, 1)
+	require.Equal(t, aggressive.ClientOrderID, history[0].ClientOrderID)
+	require.Equal(t, types.OrderStatus_Canceled, history[0].Status)
+
+	// The resting order is canceled and the aggressive order matches the next best order
+	ctx, k, acc1, acc2, own := setup()
+	aggressive = order(ctx.BlockTime(), acc1, "125usd", "100eur")
+	aggressive.SelfTradePrevention = types.SelfTradePrevention_CancelOldest
+	require.NoError(t, k.NewOrderSingle(ctx, aggressive))
+
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+
+	expired = selfTradeExpiries(ctx, types.ExpireReason_SelfTradeOldest)
+	require.Len(t, expired, 1)
+	cid, _ = getEventAttrValue(expired[0], types.AttributeKeyClientOrderID)
+	require.Equal(t, own.ClientOrderID, cid)
+
+	history, _, err = k.GetOrderHistory(ctx, acc1.GetAddress(), nil)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, own.ClientOrderID, history[0].ClientOrderID)
+	require.Equal(t, types.OrderStatus_Canceled, history[0].Status)
+	require.Equal(t, aggressive.ClientOrderID, history[1].ClientOrderID)
+	require.Equal(t, types.OrderStatus_Filled, history[1].Status)
+
+	// Both orders are canceled
+	ctx, k, acc1, acc2, _ = setup()
+	aggressive = order(ctx.BlockTime(), acc1, "125usd", "100eur")
+	aggressive.SelfTradePrevention = types.SelfTradePrevention_CancelBoth
+	require.NoError(t, k.NewOrderSingle(ctx, aggressive))
+
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 1)
+	require.Len(t, selfTradeExpiries(ctx, types.ExpireReason_SelfTradeOldest), 1)
+	require.Len(t, selfTradeExpiries(ctx, types.ExpireReason_SelfTradeNewest), 1)
+}
+
+func TestIcebergOrder(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	iceberg := order(ctx.BlockTime(), acc1, "1000eur", "1200usd")
+	displaySize := sdk.NewInt(100)
+	iceberg.DisplaySize = &displaySize
+	require.NoError(t, k.NewOrderSingle(ctx, iceberg))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100eur", "120usd")))
+
+	// Only the displayed slice is visible in the book
+	levels := k.GetDepth(ctx, "eur", "usd", 10)
+	require.Len(t, levels, 1)
+	require.Equal(t, sdk.NewInt(200), levels[0].SourceRemaining)
+	require.Equal(t, uint32(2), levels[0].OrderCount)
+
+	orders := k.getInstrumentOrders(ctx, "eur", "usd", 0)
+	require.Len(t, orders, 2)
+	require.Equal(t, acc1.GetAddress().String(), orders[0].Owner)
+	require.Equal(t, "100", orders[0].SourceRemaining)
+
+	// Filling the slice replenishes the order, which loses its priority
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "120usd", "100eur")))
+
+	orders = k.getInstrumentOrders(ctx, "eur", "usd", 0)
+	require.Len(t, orders, 2)
+	require.Equal(t, acc2.GetAddress().String(), orders[0].Owner)
+	require.Equal(t, acc1.GetAddress().String(), orders[1].Owner)
+	require.Equal(t, "100", orders[1].SourceRemaining)
+
+	replenished := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), iceberg.ClientOrderID)
+	require.Equal(t, sdk.NewInt(900), replenished.SourceRemaining)
+	require.Equal(t, sdk.NewInt(100), *replenished.DisplayRemaining)
+	require.Greater(t, replenished.Priority, replenished.ID)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "120usd", "100eur")))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+
+	// Larger orders are matched against consecutive slices
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "600usd", "500eur")))
+	require.Equal(t, "700eur,4160usd", bk.GetAllBalances(ctx, acc3.GetAddress()).String())
+
+	replenished = k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), iceberg.ClientOrderID)
+	require.Equal(t, sdk.NewInt(400), replenished.SourceRemaining)
+	require.Equal(t, sdk.NewInt(100), *replenished.DisplayRemaining)
+
+	_, broken := AllInvariants(k)(ctx)
+	require.False(t, broken)
+}
+
+func TestTradingFees(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	params := types.DefaultParams()
+	params.MakerFee = sdk.MustNewDecFromStr("0.01")
+	params.TakerFee = sdk.MustNewDecFromStr("0.02")
+	k.SetParams(ctx, params)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "5000chf")
+	feeAddr := ak.GetModuleAddress(types.ModuleName)
+	initialFees := bk.GetAllBalances(ctx, feeAddr)
+	feesCollected := func() string {
+		return bk.GetAllBalances(ctx, feeAddr).Sub(initialFees).String()
+	}
+
+	totalSupply := snapshotAccounts(ctx, bk)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+
+	// Fees are deducted from the received amounts
+	require.Equal(t, "4900eur,119usd", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	require.Equal(t, "98eur,4880usd", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+	require.Equal(t, "2eur,1usd", feesCollected())
+
+	fills := filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "fill")
+	require.Len(t, fills, 2)
+	fee, _ := getEventAttrValue(fills[0], types.AttributeKeyFee)
+	require.Equal(t, "1usd", fee)
+	fee, _ = getEventAttrValue(fills[1], types.AttributeKeyFee)
+	require.Equal(t, "2eur", fee)
+
+	// Synthetic instruments only charge the taker on the final leg
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "100chf")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "100chf", "100usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100usd", "100eur")))
+
+	acc2Balance := bk.GetAllBalances(ctx, acc2.GetAddress())
+	require.Equal(t, sdk.NewInt(196), acc2Balance.AmountOf("eur"))
+	require.True(t, acc2Balance.AmountOf("chf").IsZero())
+	require.Equal(t, "1chf,4eur,2usd", feesCollected())
+
+	// Fees are moved, never created or destroyed
+	require.True(t, totalSupply.Sub(snapshotAccounts(ctx, bk)).IsZero())
+}
+
 func TestKeeperCancelReplaceLimitOrder(t *testing.T) {
 	ctx, k, ak, bk := createTestComponents(t)
 	acc1 := createAccount(ctx, ak, bk, randomAddress(), "20000eur")
@@ -1271,6 +1770,56 @@ func TestSyntheticInstruments2(t *testing.T) {
 	require.True(t, totalSupply.Sub(snapshotAccounts(ctx, bk)).IsZero())
 }
 
+func TestSyntheticInstruments3(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "500gbp")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "500aud")
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "500chf")
+	acc4 := createAccount(ctx, ak, bk, randomAddress(), "500eur,500gbp,500sek")
+
+	totalSupply := snapshotAccounts(ctx, bk)
+
+	// eur -> aud -> chf -> gbp
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100aud", "100eur")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "100chf", "100aud")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100gbp", "100chf")))
+
+	// A direct order at the same price is preferred over the longer path, even though aud sorts before gbp
+	direct := order(ctx.BlockTime(), acc4, "50gbp", "50eur")
+	require.NoError(t, k.NewOrderSingle(ctx, direct))
+
+	acc5 := createAccount(ctx, ak, bk, randomAddress(), "500eur")
+	totalSupply = totalSupply.Add(coins("500eur")...)
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc5, "50eur", "50gbp")))
+	require.Nil(t, k.GetOrderByOwnerAndClientOrderId(ctx, acc4.GetAddress().String(), direct.ClientOrderID))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 1)
+
+	// The remaining liquidity is only available through three legs
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc5, "100eur", "100gbp")))
+	require.Equal(t, "350eur,150gbp", bk.GetAllBalances(ctx, acc5.GetAddress()).String())
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()))
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc3.GetAddress()))
+
+	// Intermediate tokens are passed on in full
+	require.Equal(t, "100chf,400gbp", bk.GetAllBalances(ctx, acc1.GetAddress()).String())
+	require.Equal(t, "400aud,100eur", bk.GetAllBalances(ctx, acc2.GetAddress()).String())
+	require.Equal(t, "100aud,400chf", bk.GetAllBalances(ctx, acc3.GetAddress()).String())
+
+	// Paths of more than three legs are not considered: sek -> eur -> aud -> chf -> gbp
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc5, "100eur", "100sek")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "100aud", "100eur")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "100chf", "100aud")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100gbp", "100chf")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc4, "100sek", "100gbp")))
+	require.Len(t, k.GetOrdersByOwner(ctx, acc4.GetAddress()), 1)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc5.GetAddress()), 1)
+
+	// Ensure that all tokens are accounted for.
+	require.True(t, totalSupply.Sub(snapshotAccounts(ctx, bk)).IsZero())
+}
+
 func TestDestinationCapacity(t *testing.T) {
 	ctx, k, ak, bk := createTestComponents(t)
 
@@ -1430,11 +1979,11 @@ func TestGetNextOrderNumber(t *testing.T) {
 	require.Equal(t, uint64(2), k.getNextOrderNumber(ctx)) // increments counter
 }
 
-func createTestComponents(t *testing.T) (sdk.Context, *Keeper, authkeeper.AccountKeeper, *embank.ProxyKeeper) {
+func createTestComponents(t testing.TB) (sdk.Context, *Keeper, authkeeper.AccountKeeper, *embank.ProxyKeeper) {
 	return createTestComponentsWithEncoding(t, MakeTestEncodingConfig())
 }
 
-func createTestComponentsWithEncoding(t *testing.T, encConfig simappparams.EncodingConfig) (sdk.Context, *Keeper, authkeeper.AccountKeeper, *embank.ProxyKeeper) {
+func createTestComponentsWithEncoding(t testing.TB, encConfig simappparams.EncodingConfig) (sdk.Context, *Keeper, authkeeper.AccountKeeper, *embank.ProxyKeeper) {
 	t.Helper()
 
 	var (
@@ -1458,6 +2007,7 @@ func createTestComponentsWithEncoding(t *testing.T, encConfig simappparams.Encod
 	ms.MountStoreWithDB(keyIndices, sdk.StoreTypeMemory, db2)
 	ms.MountStoreWithDB(keyAuthCap, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
 
 	err := ms.LoadLatestVersion()
@@ -1480,7 +2030,7 @@ func createTestComponentsWithEncoding(t *testing.T, encConfig simappparams.Encod
 	err = mintBalance(ctx, bk, coins("1eur,1usd,1chf,1jpy,1gbp,1ngm"))
 	require.NoError(t, err)
 
-	marketKeeper := NewKeeper(encConfig.Marshaler, keyMarket, keyIndices, ak, wrappedBank)
+	marketKeeper := NewKeeper(encConfig.Marshaler, keyMarket, keyIndices, ak, wrappedBank, pk.Subspace(types.ModuleName), types.ModuleName)
 	return ctx, marketKeeper, ak, wrappedBank
 }
 
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v1 "github.com/e-money/em-ledger/x/market/legacy/v1"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+// loadStoreFixture writes the key/value pairs of a store fixture, which maps store names to hex encoded pairs.
+func loadStoreFixture(t *testing.T, ctx sdk.Context, k *Keeper, path string) {
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var fixture map[string][][2]string
+	require.NoError(t, json.Unmarshal(bz, &fixture))
+
+	for name, key := range map[string]sdk.StoreKey{types.StoreKey: k.key, types.StoreKeyIdx: k.keyIndices} {
+		store := ctx.KVStore(key)
+		for _, kv := range fixture[name] {
+			key, err := hex.DecodeString(kv[0])
+			require.NoError(t, err)
+			value, err := hex.DecodeString(kv[1])
+			require.NoError(t, err)
+			store.Set(key, value)
+		}
+	}
+}
+
+func TestMigrate1to2(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+
+	const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	// The fixture was exported from version 1 of the module after the following orders were placed:
+	//  acc1: 100 ibcDenom -> 100eur, 100eur -> 120usd
+	//  acc2: 60usd -> 50eur, 100chf -> 110eur
+	// The usd order of acc2 filled half of the eur order of acc1.
+	acc1 := createAccount(ctx, ak, bk, sdk.AccAddress("v1-fixture-account-1"), "4950eur,5000"+ibcDenom+",60usd")
+	acc2 := createAccount(ctx, ak, bk, sdk.AccAddress("v1-fixture-account-2"), "5000chf,50eur,4940usd")
+	loadStoreFixture(t, ctx, k, "testdata/v1_store.json")
+
+	v1OwnerKey := v1.GetOwnerKey(acc1.GetAddress().String(), "eur-usd")
+	require.NotNil(t, ctx.KVStore(k.key).Get(v1OwnerKey))
+	v1MarketDataKey := v1.GetMarketDataKey("eur", "usd")
+	require.NotNil(t, ctx.KVStore(k.keyIndices).Get(v1MarketDataKey))
+
+	require.NoError(t, NewMigrator(k).Migrate1to2(ctx))
+
+	require.Nil(t, ctx.KVStore(k.key).Get(v1OwnerKey))
+	require.Nil(t, ctx.KVStore(k.keyIndices).Get(v1MarketDataKey))
+
+	// Orders are found through the new keys and indices
+	require.Len(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()), 2)
+	require.Len(t, k.GetOrdersByOwner(ctx, acc2.GetAddress()), 1)
+
+	o := k.GetOrderByOwnerAndClientOrderId(ctx, acc1.GetAddress().String(), "eur-usd")
+	require.NotNil(t, o)
+	require.Equal(t, sdk.NewInt(50), o.SourceRemaining)
+	require.Equal(t, o, k.GetOrderByID(ctx, o.ID))
+	require.Equal(t, []*types.Order{o}, k.getOrdersByOwnerAndDenom(ctx, acc1.GetAddress(), "eur"))
+
+	require.Equal(t, "ibc-eur", k.getBestOrder(ctx, ibcDenom, "eur").ClientOrderID)
+	require.Len(t, k.GetInstruments(ctx), 6)
+	md := k.GetInstrument(ctx, "eur", "usd")
+	require.NotNil(t, md)
+	require.NotNil(t, md.LastPrice)
+
+	for _, invariant := range []sdk.Invariant{OrderIndicesInvariant(k), NonNegativeRemainingInvariant(k), InstrumentDemandInvariant(k)} {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+	}
+
+	// Order IDs continue and the migrated orders are matched
+	acc3 := createAccount(ctx, ak, bk, randomAddress(), "1000eur,1000usd")
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "100eur", "100"+ibcDenom)))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc3, "60usd", "50eur")))
+	require.Equal(t, sdk.NewInt(100), bk.GetBalance(ctx, acc3.GetAddress(), ibcDenom).Amount)
+	require.Equal(t, sdk.NewInt(950), bk.GetBalance(ctx, acc3.GetAddress(), "eur").Amount)
+	require.Empty(t, k.GetOrdersByOwner(ctx, acc1.GetAddress()))
+	require.Equal(t, uint64(6), k.getNextOrderNumber(ctx))
+}
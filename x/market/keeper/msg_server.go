@@ -12,9 +12,13 @@ var _ types.MsgServer = msgServer{}
 
 type marketKeeper interface {
 	NewOrderSingle(ctx sdk.Context, aggressiveOrder types.Order) error
+	NewSourceMarketOrder(ctx sdk.Context, order types.Order) error
 	CancelOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) error
 	CancelReplaceLimitOrder(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error
+	CancelAllOrders(ctx sdk.Context, owner sdk.AccAddress, src, dst string) uint32
+	AddConditionalOrder(ctx sdk.Context, co types.ConditionalOrder) error
 	GetSrcFromSlippage(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec) (sdk.Coin, error)
+	GetDstFromSlippage(ctx sdk.Context, src sdk.Coin, dstDenom string, maxSlippage sdk.Dec) (sdk.Coin, error)
 }
 type msgServer struct {
 	k marketKeeper
@@ -31,10 +35,13 @@ func (m msgServer) AddLimitOrder(c context.Context, msg *types.MsgAddLimitOrder)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
 	}
 
-	order, err := types.NewOrder(ctx.BlockTime(), msg.TimeInForce, msg.Source, msg.Destination, owner, msg.ClientOrderId)
+	order, err := types.NewOrderWithExpiry(ctx.BlockTime(), msg.TimeInForce, msg.Source, msg.Destination, owner, msg.ClientOrderId, msg.GoodTillTime, msg.GoodTillBlock)
 	if err != nil {
 		return nil, err
 	}
+	order.PostOnly = msg.PostOnly
+	order.SelfTradePrevention = msg.SelfTradePrevention
+	order.DisplaySize = msg.DisplaySize
 
 	err = m.k.NewOrderSingle(ctx, order)
 	if err != nil {
@@ -55,17 +62,47 @@ func (m msgServer) AddMarketOrder(c context.Context, msg *types.MsgAddMarketOrde
 	}
 
 	limitMsg := &types.MsgAddLimitOrder{
-		Owner:         msg.Owner,
-		ClientOrderId: msg.ClientOrderId,
-		TimeInForce:   msg.TimeInForce,
-		Source:        slippageSource,
-		Destination:   msg.Destination,
+		Owner:               msg.Owner,
+		ClientOrderId:       msg.ClientOrderId,
+		TimeInForce:         msg.TimeInForce,
+		Source:              slippageSource,
+		Destination:         msg.Destination,
+		SelfTradePrevention: msg.SelfTradePrevention,
 	}
 
 	_, err = m.AddLimitOrder(c, limitMsg)
 	return &types.MsgAddMarketOrderResponse{}, err
 }
 
+func (m msgServer) AddSourceMarketOrder(c context.Context, msg *types.MsgAddSourceMarketOrder) (*types.MsgAddSourceMarketOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	slippageDestination, err := m.k.GetDstFromSlippage(
+		ctx, msg.Source, msg.Destination, msg.MaxSlippage,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	order, err := types.NewOrder(ctx.BlockTime(), msg.TimeInForce, msg.Source, slippageDestination, owner, msg.ClientOrderId)
+	if err != nil {
+		return nil, err
+	}
+	order.SelfTradePrevention = msg.SelfTradePrevention
+
+	err = m.k.NewSourceMarketOrder(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddSourceMarketOrderResponse{}, nil
+}
+
 func (m msgServer) CancelOrder(c context.Context, msg *types.MsgCancelOrder) (*types.MsgCancelOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -88,10 +125,13 @@ func (m msgServer) CancelReplaceLimitOrder(c context.Context, msg *types.MsgCanc
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
 	}
-	order, err := types.NewOrder(ctx.BlockTime(), msg.TimeInForce, msg.Source, msg.Destination, owner, msg.NewClientOrderId)
+	order, err := types.NewOrderWithExpiry(ctx.BlockTime(), msg.TimeInForce, msg.Source, msg.Destination, owner, msg.NewClientOrderId, msg.GoodTillTime, msg.GoodTillBlock)
 	if err != nil {
 		return nil, err
 	}
+	order.PostOnly = msg.PostOnly
+	order.SelfTradePrevention = msg.SelfTradePrevention
+	order.DisplaySize = msg.DisplaySize
 
 	err = m.k.CancelReplaceLimitOrder(ctx, order, msg.OrigClientOrderId)
 	if err != nil {
@@ -116,14 +156,81 @@ func (m msgServer) CancelReplaceMarketOrder(c context.Context, msg *types.MsgCan
 	}
 
 	limitMsg := &types.MsgCancelReplaceLimitOrder{
-		Owner:             msg.Owner,
-		OrigClientOrderId: msg.OrigClientOrderId,
-		NewClientOrderId:  msg.NewClientOrderId,
-		TimeInForce:       msg.TimeInForce,
-		Source:            slippageSource,
-		Destination:       msg.Destination,
+		Owner:               msg.Owner,
+		OrigClientOrderId:   msg.OrigClientOrderId,
+		NewClientOrderId:    msg.NewClientOrderId,
+		TimeInForce:         msg.TimeInForce,
+		Source:              slippageSource,
+		Destination:         msg.Destination,
+		SelfTradePrevention: msg.SelfTradePrevention,
 	}
 
 	_, err = m.CancelReplaceLimitOrder(c, limitMsg)
 	return &types.MsgCancelReplaceMarketOrderResponse{}, err
 }
+
+func (m msgServer) BatchOrders(c context.Context, msg *types.MsgBatchOrders) (*types.MsgBatchOrdersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Each item is charged its own fixed gas amount. Changes are only committed if all items succeed.
+	batchCtx, commitBatch := ctx.CacheContext()
+	batchC := sdk.WrapSDKContext(batchCtx)
+
+	batch := msg.WithItemOwners()
+	for i := range batch.Cancels {
+		if _, err := m.CancelOrder(batchC, &batch.Cancels[i]); err != nil {
+			return nil, sdkerrors.Wrapf(err, "cancel %v", i)
+		}
+	}
+
+	for i := range batch.CancelReplaces {
+		if _, err := m.CancelReplaceLimitOrder(batchC, &batch.CancelReplaces[i]); err != nil {
+			return nil, sdkerrors.Wrapf(err, "cancel-replace %v", i)
+		}
+	}
+
+	for i := range batch.LimitOrders {
+		if _, err := m.AddLimitOrder(batchC, &batch.LimitOrders[i]); err != nil {
+			return nil, sdkerrors.Wrapf(err, "limit order %v", i)
+		}
+	}
+
+	commitBatch()
+	ctx.EventManager().EmitEvents(batchCtx.EventManager().Events())
+
+	return &types.MsgBatchOrdersResponse{}, nil
+}
+
+func (m msgServer) CancelAllOrders(c context.Context, msg *types.MsgCancelAllOrders) (*types.MsgCancelAllOrdersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	canceled := m.k.CancelAllOrders(ctx, owner, msg.Source, msg.Destination)
+	return &types.MsgCancelAllOrdersResponse{Canceled: canceled}, nil
+}
+
+func (m msgServer) AddConditionalOrder(c context.Context, msg *types.MsgAddConditionalOrder) (*types.MsgAddConditionalOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "owner")
+	}
+
+	order, err := types.NewOrderWithExpiry(ctx.BlockTime(), msg.TimeInForce, msg.Source, msg.Destination, owner, msg.ClientOrderId, msg.GoodTillTime, msg.GoodTillBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	order.SelfTradePrevention = msg.SelfTradePrevention
+
+	err = m.k.AddConditionalOrder(ctx, types.NewConditionalOrder(order, msg.Condition, msg.TriggerPrice))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddConditionalOrderResponse{}, nil
+}
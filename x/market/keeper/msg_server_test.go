@@ -258,6 +258,120 @@ func TestAddMarketOrder(t *testing.T) {
 	}
 }
 
+func TestAddSourceMarketOrder(t *testing.T) {
+	var (
+		ownerAddr      = randomAccAddress()
+		gotSrc         sdk.Coin
+		gotDstDenom    string
+		gotMaxSlippage sdk.Dec
+		gotOrder       types.Order
+	)
+
+	keeper := marketKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	specs := map[string]struct {
+		req                      *types.MsgAddSourceMarketOrder
+		mockSourceMarketOrderFn  func(ctx sdk.Context, order types.Order) error
+		mockGetDstFromSlippageFn func(ctx sdk.Context, src sdk.Coin, dstDenom string, maxSlippage sdk.Dec) (sdk.Coin, error)
+		expErr                   bool
+		expOrder                 types.Order
+	}{
+		"all good": {
+			req: &types.MsgAddSourceMarketOrder{
+				Owner:               ownerAddr.String(),
+				ClientOrderId:       "myClientIOrderID",
+				TimeInForce:         types.TimeInForce_ImmediateOrCancel,
+				Source:              sdk.NewCoin("eeur", sdk.NewInt(100)),
+				Destination:         "echf",
+				MaxSlippage:         sdk.NewDecWithPrec(5, 2),
+				SelfTradePrevention: types.SelfTradePrevention_CancelNewest,
+			},
+			mockGetDstFromSlippageFn: func(ctx sdk.Context, src sdk.Coin, dstDenom string, maxSlippage sdk.Dec) (sdk.Coin, error) {
+				gotSrc, gotDstDenom, gotMaxSlippage = src, dstDenom, maxSlippage
+				return sdk.NewCoin(dstDenom, sdk.NewInt(95)), nil
+			},
+			mockSourceMarketOrderFn: func(ctx sdk.Context, order types.Order) error {
+				gotOrder = order
+				return nil
+			},
+			expOrder: types.Order{
+				TimeInForce:         types.TimeInForce_ImmediateOrCancel,
+				Owner:               ownerAddr.String(),
+				ClientOrderID:       "myClientIOrderID",
+				Source:              sdk.NewCoin("eeur", sdk.NewInt(100)),
+				SourceRemaining:     sdk.NewInt(100),
+				SourceFilled:        sdk.ZeroInt(),
+				Destination:         sdk.NewCoin("echf", sdk.NewInt(95)),
+				DestinationFilled:   sdk.ZeroInt(),
+				SelfTradePrevention: types.SelfTradePrevention_CancelNewest,
+			},
+		},
+		"owner invalid": {
+			req: &types.MsgAddSourceMarketOrder{
+				Owner:         "invalid",
+				ClientOrderId: "myClientIOrderID",
+				TimeInForce:   types.TimeInForce_ImmediateOrCancel,
+				Source:        sdk.NewCoin("eeur", sdk.NewInt(100)),
+				Destination:   "echf",
+				MaxSlippage:   sdk.NewDecWithPrec(5, 2),
+			},
+			mockGetDstFromSlippageFn: func(ctx sdk.Context, src sdk.Coin, dstDenom string, maxSlippage sdk.Dec) (sdk.Coin, error) {
+				return sdk.NewCoin(dstDenom, sdk.NewInt(95)), nil
+			},
+			expErr: true,
+		},
+		"slippage func fails": {
+			req: &types.MsgAddSourceMarketOrder{
+				Owner:         ownerAddr.String(),
+				ClientOrderId: "myClientIOrderID",
+				TimeInForce:   types.TimeInForce_ImmediateOrCancel,
+				Source:        sdk.NewCoin("eeur", sdk.NewInt(100)),
+				Destination:   "echf",
+				MaxSlippage:   sdk.NewDecWithPrec(5, 2),
+			},
+			mockGetDstFromSlippageFn: func(ctx sdk.Context, src sdk.Coin, dstDenom string, maxSlippage sdk.Dec) (sdk.Coin, error) {
+				return sdk.Coin{}, sdkerrors.Wrap(types.ErrNoLiquidity, "xxx")
+			},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgAddSourceMarketOrder{
+				Owner:         ownerAddr.String(),
+				ClientOrderId: "myClientIOrderID",
+				TimeInForce:   types.TimeInForce_ImmediateOrCancel,
+				Source:        sdk.NewCoin("eeur", sdk.NewInt(100)),
+				Destination:   "echf",
+				MaxSlippage:   sdk.NewDecWithPrec(5, 2),
+			},
+			mockGetDstFromSlippageFn: func(ctx sdk.Context, src sdk.Coin, dstDenom string, maxSlippage sdk.Dec) (sdk.Coin, error) {
+				return sdk.NewCoin(dstDenom, sdk.NewInt(95)), nil
+			},
+			mockSourceMarketOrderFn: func(ctx sdk.Context, order types.Order) error {
+				return errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.GetDstFromSlippageFn = spec.mockGetDstFromSlippageFn
+			keeper.NewSourceMarketOrderFn = spec.mockSourceMarketOrderFn
+			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(sdk.NewEventManager())
+			_, gotErr := svr.AddSourceMarketOrder(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, spec.expOrder.String(), gotOrder.String())
+			assert.Equal(t, spec.req.Source, gotSrc)
+			assert.Equal(t, spec.req.Destination, gotDstDenom)
+			assert.Equal(t, spec.req.MaxSlippage, gotMaxSlippage)
+		})
+	}
+}
+
 func TestCancelOrder(t *testing.T) {
 	var (
 		ownerAddr        = randomAccAddress()
@@ -336,6 +450,136 @@ func TestCancelOrder(t *testing.T) {
 	}
 }
 
+func TestCancelAllOrders(t *testing.T) {
+	var (
+		ownerAddr          = randomAccAddress()
+		gotOwner           sdk.AccAddress
+		gotSource, gotDest string
+	)
+
+	keeper := marketKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	specs := map[string]struct {
+		req         *types.MsgCancelAllOrders
+		mockFn      func(ctx sdk.Context, owner sdk.AccAddress, src, dst string) uint32
+		expErr      bool
+		expCanceled uint32
+	}{
+		"all good": {
+			req: &types.MsgCancelAllOrders{
+				Owner:       ownerAddr.String(),
+				Source:      "eeur",
+				Destination: "echf",
+			},
+			mockFn: func(ctx sdk.Context, owner sdk.AccAddress, src, dst string) uint32 {
+				gotOwner, gotSource, gotDest = owner, src, dst
+				return 3
+			},
+			expCanceled: 3,
+		},
+		"owner invalid": {
+			req: &types.MsgCancelAllOrders{
+				Owner: "invalid",
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.CancelAllOrdersFn = spec.mockFn
+			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(sdk.NewEventManager())
+			gotRes, gotErr := svr.CancelAllOrders(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, spec.expCanceled, gotRes.Canceled)
+			assert.Equal(t, ownerAddr, gotOwner)
+			assert.Equal(t, spec.req.Source, gotSource)
+			assert.Equal(t, spec.req.Destination, gotDest)
+		})
+	}
+}
+
+func TestAddConditionalOrder(t *testing.T) {
+	var (
+		ownerAddr = randomAccAddress()
+		gotCo     types.ConditionalOrder
+	)
+
+	keeper := marketKeeperMock{}
+	svr := NewMsgServerImpl(&keeper)
+
+	specs := map[string]struct {
+		req    *types.MsgAddConditionalOrder
+		mockFn func(ctx sdk.Context, co types.ConditionalOrder) error
+		expErr bool
+	}{
+		"all good": {
+			req: &types.MsgAddConditionalOrder{
+				Owner:         ownerAddr.String(),
+				ClientOrderId: "myClientIOrderID",
+				TimeInForce:   types.TimeInForce_GoodTillCancel,
+				Source:        sdk.NewCoin("eur", sdk.OneInt()),
+				Destination:   sdk.NewCoin("usd", sdk.OneInt()),
+				Condition:     types.ConditionType_TakeProfit,
+				TriggerPrice:  sdk.MustNewDecFromStr("1.5"),
+			},
+			mockFn: func(ctx sdk.Context, co types.ConditionalOrder) error {
+				gotCo = co
+				return nil
+			},
+		},
+		"owner invalid": {
+			req: &types.MsgAddConditionalOrder{
+				Owner:         "invalid",
+				ClientOrderId: "myClientIOrderID",
+				TimeInForce:   types.TimeInForce_GoodTillCancel,
+				Source:        sdk.NewCoin("eur", sdk.OneInt()),
+				Destination:   sdk.NewCoin("usd", sdk.OneInt()),
+				Condition:     types.ConditionType_TakeProfit,
+				TriggerPrice:  sdk.MustNewDecFromStr("1.5"),
+			},
+			expErr: true,
+		},
+		"processing failure": {
+			req: &types.MsgAddConditionalOrder{
+				Owner:         ownerAddr.String(),
+				ClientOrderId: "myClientIOrderID",
+				TimeInForce:   types.TimeInForce_GoodTillCancel,
+				Source:        sdk.NewCoin("eur", sdk.OneInt()),
+				Destination:   sdk.NewCoin("usd", sdk.OneInt()),
+				Condition:     types.ConditionType_TakeProfit,
+				TriggerPrice:  sdk.MustNewDecFromStr("1.5"),
+			},
+			mockFn: func(ctx sdk.Context, co types.ConditionalOrder) error {
+				return errors.New("testing")
+			},
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			keeper.AddConditionalOrderFn = spec.mockFn
+			ctx := sdk.Context{}.WithContext(context.Background()).WithEventManager(sdk.NewEventManager())
+			_, gotErr := svr.AddConditionalOrder(sdk.WrapSDKContext(ctx), spec.req)
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			assert.Equal(t, ownerAddr.String(), gotCo.Order.Owner)
+			assert.Equal(t, spec.req.ClientOrderId, gotCo.Order.ClientOrderID)
+			assert.Equal(t, spec.req.Source, gotCo.Order.Source)
+			assert.Equal(t, spec.req.Destination, gotCo.Order.Destination)
+			assert.Equal(t, spec.req.Condition, gotCo.Condition)
+			assert.Equal(t, spec.req.TriggerPrice, gotCo.TriggerPrice)
+		})
+	}
+}
+
 func TestCancelReplaceLimitOrder(t *testing.T) {
 	var (
 		ownerAddr            = randomAccAddress()
@@ -601,12 +845,74 @@ func TestCancelReplaceMarketOrder(t *testing.T) {
 	}
 }
 
+func TestBatchOrders(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	svr := NewMsgServerImpl(k)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	owner := acc1.GetAddress().String()
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	origClientOrderID := k.GetOrdersByOwner(ctx, acc1.GetAddress())[0].ClientOrderID
+
+	limitOrder := func(clientOrderID, src, dst string) types.MsgAddLimitOrder {
+		return types.MsgAddLimitOrder{
+			ClientOrderId: clientOrderID,
+			TimeInForce:   types.TimeInForce_GoodTillCancel,
+			Source:        coin(src),
+			Destination:   coin(dst),
+		}
+	}
+
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	_, err := svr.BatchOrders(sdk.WrapSDKContext(ctx), &types.MsgBatchOrders{
+		Owner:       owner,
+		Cancels:     []types.MsgCancelOrder{{ClientOrderId: origClientOrderID}},
+		LimitOrders: []types.MsgAddLimitOrder{limitOrder("B", "100eur", "120usd"), limitOrder("C", "100eur", "130usd")},
+	})
+	require.NoError(t, err)
+
+	// Gas is charged per item
+	require.Equal(t, gasPriceCancelOrder+2*gasPriceNewOrder, ctx.GasMeter().GasConsumed())
+	require.Len(t, filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "accept"), 2)
+	require.Len(t, filterEvents(ctx, types.EventTypeMarket, types.AttributeKeyAction, "expire"), 1)
+
+	clientOrderIDs := func() (ids []string) {
+		for _, o := range k.GetOrdersByOwner(ctx, acc1.GetAddress()) {
+			ids = append(ids, o.ClientOrderID)
+		}
+		return
+	}
+	require.ElementsMatch(t, []string{"B", "C"}, clientOrderIDs())
+
+	// A failing item rolls back the entire batch
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = svr.BatchOrders(sdk.WrapSDKContext(ctx), &types.MsgBatchOrders{
+		Owner:   owner,
+		Cancels: []types.MsgCancelOrder{{ClientOrderId: "B"}},
+		CancelReplaces: []types.MsgCancelReplaceLimitOrder{{
+			OrigClientOrderId: "C",
+			NewClientOrderId:  "D",
+			TimeInForce:       types.TimeInForce_GoodTillCancel,
+			Source:            coin("200eur"),
+			Destination:       coin("260usd"),
+		}},
+		LimitOrders: []types.MsgAddLimitOrder{limitOrder("D", "100eur", "120usd")},
+	})
+	require.ErrorIs(t, err, types.ErrNonUniqueClientOrderId)
+	require.ElementsMatch(t, []string{"B", "C"}, clientOrderIDs())
+	require.Empty(t, ctx.EventManager().Events())
+}
+
 type marketKeeperMock struct {
 	NewMarketOrderWithSlippageFn func(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec, owner sdk.AccAddress, timeInForce types.TimeInForce, clientOrderId string) error
 	NewOrderSingleFn             func(ctx sdk.Context, aggressiveOrder types.Order) error
+	NewSourceMarketOrderFn       func(ctx sdk.Context, order types.Order) error
 	CancelOrderFn                func(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) error
 	CancelReplaceLimitOrderFn    func(ctx sdk.Context, newOrder types.Order, origClientOrderId string) error
+	CancelAllOrdersFn            func(ctx sdk.Context, owner sdk.AccAddress, src, dst string) uint32
 	GetSrcFromSlippageFn         func(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec) (sdk.Coin, error)
+	GetDstFromSlippageFn         func(ctx sdk.Context, src sdk.Coin, dstDenom string, maxSlippage sdk.Dec) (sdk.Coin, error)
+	AddConditionalOrderFn        func(ctx sdk.Context, co types.ConditionalOrder) error
 }
 
 func (m marketKeeperMock) NewMarketOrderWithSlippage(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec, owner sdk.AccAddress, timeInForce types.TimeInForce, clientOrderId string) error {
@@ -623,6 +929,13 @@ func (m marketKeeperMock) NewOrderSingle(ctx sdk.Context, aggressiveOrder types.
 	return m.NewOrderSingleFn(ctx, aggressiveOrder)
 }
 
+func (m marketKeeperMock) NewSourceMarketOrder(ctx sdk.Context, order types.Order) error {
+	if m.NewSourceMarketOrderFn == nil {
+		panic("not expected to be called")
+	}
+	return m.NewSourceMarketOrderFn(ctx, order)
+}
+
 func (m marketKeeperMock) CancelOrder(ctx sdk.Context, owner sdk.AccAddress, clientOrderId string) error {
 	if m.CancelOrderFn == nil {
 		panic("not expected to be called")
@@ -637,6 +950,20 @@ func (m marketKeeperMock) CancelReplaceLimitOrder(ctx sdk.Context, newOrder type
 	return m.CancelReplaceLimitOrderFn(ctx, newOrder, origClientOrderId)
 }
 
+func (m marketKeeperMock) CancelAllOrders(ctx sdk.Context, owner sdk.AccAddress, src, dst string) uint32 {
+	if m.CancelAllOrdersFn == nil {
+		panic("not expected to be called")
+	}
+	return m.CancelAllOrdersFn(ctx, owner, src, dst)
+}
+
+func (m marketKeeperMock) AddConditionalOrder(ctx sdk.Context, co types.ConditionalOrder) error {
+	if m.AddConditionalOrderFn == nil {
+		panic("not expected to be called")
+	}
+	return m.AddConditionalOrderFn(ctx, co)
+}
+
 func (m marketKeeperMock) GetSrcFromSlippage(ctx sdk.Context, srcDenom string, dst sdk.Coin, maxSlippage sdk.Dec) (sdk.Coin, error) {
 	if m.GetSrcFromSlippageFn == nil {
 		panic("not expected to be called")
@@ -644,6 +971,13 @@ func (m marketKeeperMock) GetSrcFromSlippage(ctx sdk.Context, srcDenom string, d
 	return m.GetSrcFromSlippageFn(ctx, srcDenom, dst, maxSlippage)
 }
 
+func (m marketKeeperMock) GetDstFromSlippage(ctx sdk.Context, src sdk.Coin, dstDenom string, maxSlippage sdk.Dec) (sdk.Coin, error) {
+	if m.GetDstFromSlippageFn == nil {
+		panic("not expected to be called")
+	}
+	return m.GetDstFromSlippageFn(ctx, src, dstDenom, maxSlippage)
+}
+
 func randomAccAddress() sdk.AccAddress {
 	const legacyAddrLen = 20
 	return rand.Bytes(legacyAddrLen)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strconv"
 
 	"github.com/e-money/em-ledger/x/market/types"
 
@@ -25,6 +26,8 @@ func NewQuerier(k *Keeper) sdk.Querier {
 			return queryInstrument(ctx, k, path[1:], req)
 		case types.QueryByAccount:
 			return queryByAccount(ctx, k, path[1:], req)
+		case types.QueryDepth:
+			return queryDepthLegacy(ctx, k, path[1:], req)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unrecognized market query endpoint")
 		}
@@ -64,6 +67,10 @@ func queryByAccount(ctx sdk.Context, k *Keeper, path []string, req abci.RequestQ
 	// o := k.accountOrders.GetAllOrders(account)
 	orders := k.GetOrdersByOwner(ctx, account)
 	// orders := make(OrderResponses, 0)
+	for i, order := range orders {
+		redacted := order.Redacted()
+		orders[i] = &redacted
+	}
 
 	sort.Slice(
 		orders, func(i, j int) bool {
@@ -86,33 +93,34 @@ func queryInstrument(ctx sdk.Context, k *Keeper, path []string, req abci.Request
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid denoms: %v %v", source, destination)
 	}
 
-	orders := make([]types.QueryOrderResponse, 0)
-
-	idxStore := ctx.KVStore(k.keyIndices)
-	key := types.GetPriorityKeyBySrcAndDst(source, destination)
+	orders := k.getInstrumentOrders(ctx, source, destination, 0)
 
-	it := sdk.KVStorePrefixIterator(idxStore, key)
-	defer it.Close()
+	resp := types.QueryInstrumentResponse{
+		Source:      source,
+		Destination: destination,
+		Orders:      orders,
+	}
 
-	for it.Valid() {
-		order := new(types.Order)
-		k.cdc.MustUnmarshal(it.Value(), order)
+	return json.Marshal(resp)
+}
 
-		orders = append(orders, types.QueryOrderResponse{
-			ID:              order.ID,
-			Owner:           order.Owner,
-			SourceRemaining: order.SourceRemaining.String(),
-			Price:           order.Price(),
-			Created:         order.Created,
-		})
+func queryDepthLegacy(ctx sdk.Context, k *Keeper, path []string, req abci.RequestQuery) ([]byte, error) {
+	if len(path) != 2 && len(path) != 3 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "%s is not a valid query request path", req.Path)
+	}
 
-		it.Next()
+	var levels uint64
+	if len(path) == 3 {
+		var err error
+		levels, err = strconv.ParseUint(path[2], 10, 32)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Invalid number of levels: %v", path[2])
+		}
 	}
 
-	resp := types.QueryInstrumentResponse{
-		Source:      source,
-		Destination: destination,
-		Orders:      orders,
+	resp, err := queryDepth(ctx, k, path[0], path[1], uint32(levels))
+	if err != nil {
+		return nil, err
 	}
 
 	return json.Marshal(resp)
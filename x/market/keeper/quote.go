@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/gogo/protobuf/proto"
+)
+
+// quoteOrder places order on a cached context that is discarded afterwards and reports how it was executed, using the
+// events emitted by NewOrderSingle. The order is assigned a client order ID that is unused by its owner.
+func (k *Keeper) quoteOrder(ctx sdk.Context, order types.Order) (*types.QueryQuoteResponse, error) {
+	order.ClientOrderID = "quote"
+	for i := 1; k.GetOrderByOwnerAndClientOrderId(ctx, order.Owner, order.ClientOrderID) != nil ||
+		k.GetConditionalOrder(ctx, order.Owner, order.ClientOrderID) != nil; i++ {
+		order.ClientOrderID = fmt.Sprintf("quote-%d", i)
+	}
+
+	// Conditional orders are only triggered by the outermost order, so they are left out by placing the order as if it
+	// was triggered itself.
+	quoteCtx, _ := ctx.CacheContext()
+	quoteCtx = quoteCtx.WithEventManager(sdk.NewEventManager()).WithValue(triggeredOrderKey{}, true)
+
+	if err := k.NewOrderSingle(quoteCtx, order); err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryQuoteResponse{
+		SourceFilled:      sdk.ZeroInt(),
+		DestinationFilled: sdk.ZeroInt(),
+		Fee:               sdk.ZeroInt(),
+		AveragePrice:      sdk.ZeroDec(),
+		Fills:             []types.QuoteFill{},
+		Legs:              []types.QuoteLeg{},
+		Resting:           k.GetOrderByOwnerAndClientOrderId(quoteCtx, order.Owner, order.ClientOrderID) != nil,
+	}
+
+	isQuotedOrder := func(owner, clientOrderID string) bool {
+		return owner == order.Owner && clientOrderID == order.ClientOrderID
+	}
+
+	legs := make(map[string]int)
+	for _, ev := range quoteCtx.EventManager().ABCIEvents() {
+		switch ev.Type {
+		case proto.MessageName(&types.EventOrderFilled{}), proto.MessageName(&types.EventOrderExpired{}):
+		default:
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(ev)
+		if err != nil {
+			return nil, err
+		}
+
+		switch e := msg.(type) {
+		case *types.EventOrderExpired:
+			if isQuotedOrder(e.Owner, e.ClientOrderID) {
+				res.ExpireReason = e.Reason
+			}
+
+		case *types.EventOrderFilled:
+			if e.Aggressive {
+				if isQuotedOrder(e.Owner, e.ClientOrderID) {
+					res.SourceFilled = res.SourceFilled.Add(e.SourceFilled.Amount)
+					res.DestinationFilled = res.DestinationFilled.Add(e.DestinationFilled.Amount)
+					res.Fee = res.Fee.Add(e.Fee.Amount)
+				}
+				continue
+			}
+
+			// The passive order sells what the quoted order buys in this instrument.
+			fill := types.QuoteFill{
+				OrderID:           e.OrderID,
+				Source:            e.DestinationFilled.Denom,
+				Destination:       e.SourceFilled.Denom,
+				SourceFilled:      e.DestinationFilled.Amount,
+				DestinationFilled: e.SourceFilled.Amount,
+				Price:             e.SourceFilled.Amount.ToDec().Quo(e.DestinationFilled.Amount.ToDec()),
+			}
+			res.Fills = append(res.Fills, fill)
+
+			key := string(types.GetMarketDataKey(fill.Source, fill.Destination))
+			i, found := legs[key]
+			if !found {
+				i = len(res.Legs)
+				legs[key] = i
+				res.Legs = append(res.Legs, types.QuoteLeg{
+					Source:            fill.Source,
+					Destination:       fill.Destination,
+					SourceFilled:      sdk.ZeroInt(),
+					DestinationFilled: sdk.ZeroInt(),
+				})
+			}
+			res.Legs[i].SourceFilled = res.Legs[i].SourceFilled.Add(fill.SourceFilled)
+			res.Legs[i].DestinationFilled = res.Legs[i].DestinationFilled.Add(fill.DestinationFilled)
+
+			if fill.Source != order.Source.Denom || fill.Destination != order.Destination.Denom {
+				res.Synthetic = true
+			}
+		}
+	}
+
+	if res.SourceFilled.IsPositive() {
+		res.AveragePrice = res.DestinationFilled.ToDec().Quo(res.SourceFilled.ToDec())
+	}
+
+	switch order.TimeInForce {
+	case types.TimeInForce_ImmediateOrCancel:
+		res.Success = res.SourceFilled.IsPositive()
+	case types.TimeInForce_FillOrKill:
+		res.Success = res.ExpireReason == types.ExpireReason_Filled
+	default:
+		res.Success = res.Resting || res.ExpireReason == types.ExpireReason_Filled
+	}
+
+	return res, nil
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// recordTrade adds a fill of a passive order to the trade log and the candles of both directions of its instrument. The
+// params are those loaded for the aggressive order, so they are not read again for each fill.
+func (k Keeper) recordTrade(ctx sdk.Context, params types.Params, passiveOrder *types.Order, aggressiveOrderID uint64, sourceFilled, destinationFilled sdk.Coin) {
+	price := passiveOrder.Price()
+
+	if params.TradeHistoryLength > 0 {
+		trade := types.Trade{
+			Source:            sourceFilled,
+			Destination:       destinationFilled,
+			Price:             price,
+			PassiveOrderID:    passiveOrder.ID,
+			AggressiveOrderID: aggressiveOrderID,
+			Height:            ctx.BlockHeight(),
+			Timestamp:         ctx.BlockTime(),
+		}
+		k.appendTrade(ctx, trade, params.TradeHistoryLength)
+	}
+
+	if params.CandleHistoryLength > 0 {
+		for _, interval := range params.CandleIntervals {
+			k.updateCandle(ctx, sourceFilled.Denom, destinationFilled.Denom, interval, params.CandleHistoryLength, price, sourceFilled.Amount)
+			k.updateCandle(ctx, destinationFilled.Denom, sourceFilled.Denom, interval, params.CandleHistoryLength, sdk.OneDec().Quo(price), destinationFilled.Amount)
+		}
+	}
+}
+
+func (k Keeper) appendTrade(ctx sdk.Context, trade types.Trade, historyLength uint32) {
+	var (
+		store    = ctx.KVStore(k.key)
+		src, dst = trade.Source.Denom, trade.Destination.Denom
+	)
+
+	seqKey := types.GetTradeSequenceKey(src, dst)
+	sequence := uint64(0)
+	if bz := store.Get(seqKey); bz != nil {
+		sequence = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(seqKey, sdk.Uint64ToBigEndian(sequence+1))
+
+	trade.ID = sequence
+	store.Set(types.GetTradeKey(src, dst, sequence), k.cdc.MustMarshal(&trade))
+
+	// Prune all trades beyond the history length, which may have been reduced since the last trade.
+	if sequence+1 > uint64(historyLength) {
+		end := types.GetTradeKey(src, dst, sequence+1-uint64(historyLength))
+		deleteRange(store, types.GetTradeKeyPrefix(src, dst), end)
+	}
+}
+
+func (k Keeper) updateCandle(ctx sdk.Context, src, dst string, interval time.Duration, historyLength uint32, price sdk.Dec, volume sdk.Int) {
+	store := ctx.KVStore(k.key)
+
+	start := ctx.BlockTime().Truncate(interval).UTC()
+	key := types.GetCandleKey(src, dst, interval, start)
+
+	var candle types.Candle
+	if bz := store.Get(key); bz != nil {
+		// Unmarshal into an empty candle, as amounts are decoded in place and must not alias the traded amounts.
+		k.cdc.MustUnmarshal(bz, &candle)
+
+		candle.High = sdk.MaxDec(candle.High, price)
+		candle.Low = sdk.MinDec(candle.Low, price)
+		candle.Close = price
+		candle.Volume = candle.Volume.Add(volume)
+	} else {
+		candle = types.Candle{
+			Start:  start,
+			Open:   price,
+			High:   price,
+			Low:    price,
+			Close:  price,
+			Volume: volume,
+		}
+
+		// Prune the candles that have left the history window when a new candle is started.
+		oldest := start.Unix() - int64(historyLength-1)*int64(interval/time.Second)
+		if oldest > 0 {
+			end := types.GetCandleKey(src, dst, interval, time.Unix(oldest, 0))
+			deleteRange(store, types.GetCandleKeyPrefix(src, dst, interval), end)
+		}
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&candle))
+}
+
+func deleteRange(store sdk.KVStore, start, end []byte) {
+	var keys [][]byte
+
+	it := store.Iterator(start, end)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetTrades returns the recorded trades of an instrument, stated in the direction from src to dst.
+func (k Keeper) GetTrades(ctx sdk.Context, src, dst string, pageReq *query.PageRequest) ([]types.Trade, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.GetTradeKeyPrefix(src, dst))
+
+	trades := make([]types.Trade, 0)
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		var trade types.Trade
+		if err := k.cdc.Unmarshal(value, &trade); err != nil {
+			return err
+		}
+
+		if trade.Source.Denom != src {
+			trade.Source, trade.Destination = trade.Destination, trade.Source
+			trade.Price = sdk.OneDec().Quo(trade.Price)
+		}
+
+		trades = append(trades, trade)
+		return nil
+	})
+
+	return trades, pageRes, err
+}
+
+// GetCandles returns the candles of an instrument for the given interval.
+func (k Keeper) GetCandles(ctx sdk.Context, src, dst string, interval time.Duration, pageReq *query.PageRequest) ([]types.Candle, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.GetCandleKeyPrefix(src, dst, interval))
+
+	candles := make([]types.Candle, 0)
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		var candle types.Candle
+		if err := k.cdc.Unmarshal(value, &candle); err != nil {
+			return err
+		}
+
+		candles = append(candles, candle)
+		return nil
+	})
+
+	return candles, pageRes, err
+}
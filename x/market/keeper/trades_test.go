@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/e-money/em-ledger/x/market/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTradeHistory(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	t0 := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(t0).WithBlockHeight(5)
+
+	params := types.DefaultParams()
+	params.TradeHistoryLength = 2
+	k.SetParams(ctx, params)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "130usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+
+	trades, _, err := k.GetTrades(ctx, "eur", "usd", nil)
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+
+	// Order IDs are assigned in sequence
+	trade := trades[0]
+	require.Equal(t, uint64(0), trade.ID)
+	require.Equal(t, coin("50eur"), trade.Source)
+	require.Equal(t, coin("60usd"), trade.Destination)
+	require.Equal(t, sdk.MustNewDecFromStr("1.2"), trade.Price)
+	require.Equal(t, uint64(0), trade.PassiveOrderID)
+	require.Equal(t, uint64(2), trade.AggressiveOrderID)
+	require.Equal(t, int64(5), trade.Height)
+	require.True(t, t0.Equal(trade.Timestamp))
+
+	// Trades are stated in the requested direction
+	trades, _, err = k.GetTrades(ctx, "usd", "eur", nil)
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+	require.Equal(t, coin("60usd"), trades[0].Source)
+	require.Equal(t, coin("50eur"), trades[0].Destination)
+	require.Equal(t, sdk.OneDec().Quo(sdk.MustNewDecFromStr("1.2")), trades[0].Price)
+
+	// Older trades are pruned beyond the history length
+	ctx = ctx.WithBlockTime(t0.Add(10 * time.Second))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "130usd", "100eur")))
+
+	trades, _, err = k.GetTrades(ctx, "eur", "usd", nil)
+	require.NoError(t, err)
+	require.Len(t, trades, 2)
+	require.Equal(t, uint64(1), trades[0].ID)
+	require.Equal(t, uint64(2), trades[1].ID)
+	require.Equal(t, uint64(0), trades[0].PassiveOrderID)
+	require.Equal(t, uint64(1), trades[1].PassiveOrderID)
+	require.Equal(t, coin("50eur"), trades[1].Source)
+	require.Equal(t, coin("65usd"), trades[1].Destination)
+
+	trades, pageRes, err := k.GetTrades(ctx, "eur", "usd", &query.PageRequest{Limit: 1, Reverse: true, CountTotal: true})
+	require.NoError(t, err)
+	require.Len(t, trades, 1)
+	require.Equal(t, uint64(2), trades[0].ID)
+	require.Equal(t, uint64(2), pageRes.Total)
+
+	// A history length of zero disables the trade log
+	params.TradeHistoryLength = 0
+	k.SetParams(ctx, params)
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "120usd", "100eur")))
+
+	trades, _, err = k.GetTrades(ctx, "eur", "usd", nil)
+	require.NoError(t, err)
+	require.Len(t, trades, 2)
+	require.Equal(t, uint64(2), trades[1].ID)
+}
+
+func TestCandles(t *testing.T) {
+	ctx, k, ak, bk := createTestComponents(t)
+	t0 := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(t0)
+
+	params := types.DefaultParams()
+	params.CandleIntervals = []time.Duration{time.Minute, time.Hour}
+	params.CandleHistoryLength = 2
+	k.SetParams(ctx, params)
+
+	acc1 := createAccount(ctx, ak, bk, randomAddress(), "5000eur")
+	acc2 := createAccount(ctx, ak, bk, randomAddress(), "5000usd")
+
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "120usd")))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "100eur", "130usd")))
+
+	ctx = ctx.WithBlockTime(t0.Add(5 * time.Second))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "60usd", "50eur")))
+	ctx = ctx.WithBlockTime(t0.Add(10 * time.Second))
+	require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "130usd", "100eur")))
+
+	candles, _, err := k.GetCandles(ctx, "eur", "usd", time.Minute, nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.Candle{{
+		Start:  t0,
+		Open:   sdk.MustNewDecFromStr("1.2"),
+		High:   sdk.MustNewDecFromStr("1.3"),
+		Low:    sdk.MustNewDecFromStr("1.2"),
+		Close:  sdk.MustNewDecFromStr("1.3"),
+		Volume: sdk.NewInt(150),
+	}}, candles)
+
+	// The opposite direction is measured in its own source denomination
+	candles, _, err = k.GetCandles(ctx, "usd", "eur", time.Minute, nil)
+	require.NoError(t, err)
+	require.Len(t, candles, 1)
+	require.Equal(t, sdk.OneDec().Quo(sdk.MustNewDecFromStr("1.2")), candles[0].Open)
+	require.Equal(t, sdk.OneDec().Quo(sdk.MustNewDecFromStr("1.3")), candles[0].Low)
+	require.Equal(t, sdk.NewInt(185), candles[0].Volume)
+
+	// Unconfigured intervals have no candles
+	candles, _, err = k.GetCandles(ctx, "eur", "usd", 24*time.Hour, nil)
+	require.NoError(t, err)
+	require.Empty(t, candles)
+
+	// Candles that leave the history window are pruned
+	tradeAt := func(tm time.Time) {
+		ctx = ctx.WithBlockTime(tm)
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc1, "10eur", "12usd")))
+		require.NoError(t, k.NewOrderSingle(ctx, order(ctx.BlockTime(), acc2, "12usd", "10eur")))
+	}
+
+	tradeAt(t0.Add(time.Minute))
+	candles, _, err = k.GetCandles(ctx, "eur", "usd", time.Minute, nil)
+	require.NoError(t, err)
+	require.Len(t, candles, 2)
+
+	tradeAt(t0.Add(3*time.Minute + 30*time.Second))
+	candles, _, err = k.GetCandles(ctx, "eur", "usd", time.Minute, nil)
+	require.NoError(t, err)
+	require.Len(t, candles, 1)
+	require.True(t, t0.Add(3*time.Minute).Equal(candles[0].Start))
+	require.Equal(t, sdk.NewInt(10), candles[0].Volume)
+
+	candles, _, err = k.GetCandles(ctx, "eur", "usd", time.Hour, nil)
+	require.NoError(t, err)
+	require.Len(t, candles, 1)
+	require.Equal(t, sdk.NewInt(170), candles[0].Volume)
+	require.Equal(t, sdk.MustNewDecFromStr("1.2"), candles[0].Close)
+}
+
+func TestParamsDefaults(t *testing.T) {
+	ctx, k, _, _ := createTestComponents(t)
+
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	params := types.DefaultParams()
+	params.CandleIntervals = []time.Duration{5 * time.Minute}
+	k.SetParams(ctx, params)
+	require.Equal(t, params, k.GetParams(ctx))
+}
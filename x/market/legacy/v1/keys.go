@@ -0,0 +1,31 @@
+// Package v1 contains the store keys of the market module before denominations were length-prefixed.
+package v1
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/e-money/em-ledger/util"
+)
+
+var (
+	MarketDataPrefix = []byte{0x02}
+	PriorityPrefix   = []byte{0x03}
+	OwnerPrefix      = []byte{0x04}
+)
+
+func GetMarketDataKey(src, dst string) []byte {
+	return append(MarketDataPrefix, []byte(fmt.Sprintf("%v/%v", src, dst))...)
+}
+
+func GetPriorityKey(src, dst string, price sdk.Dec, orderId uint64) []byte {
+	res := append(PriorityPrefix, []byte(fmt.Sprintf("%v/%v/", src, dst))...)
+	res = append(res, sdk.SortableDecBytes(price)...)
+	return append(res, util.Uint64ToBytes(orderId)...)
+}
+
+func GetOwnerKey(acc, clientOrderId string) []byte {
+	res := append(OwnerPrefix, []byte(acc)...)
+	return append(res, []byte(clientOrderId)...)
+}
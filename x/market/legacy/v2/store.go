@@ -0,0 +1,85 @@
+// Package v2 migrates the market module store to length-prefixed denominations and owners, and adds the order
+// indices that version 1 did not maintain.
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1 "github.com/e-money/em-ledger/x/market/legacy/v1"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+type keyMove struct {
+	from, to, value []byte
+}
+
+// MigrateStore re-encodes the market data, owner and priority keys of version 1, which are derived from the stored
+// values. Every active order is also added to the order ID and owner denomination indices, referring to its new
+// owner key. Version 1 stored no other keys with denominations or owners.
+func MigrateStore(ctx sdk.Context, key, keyIndices sdk.StoreKey, cdc codec.BinaryCodec) error {
+	var (
+		store    = ctx.KVStore(key)
+		idxStore = ctx.KVStore(keyIndices)
+	)
+
+	// Market data
+	var marketDataMoves []keyMove
+	iterate(idxStore, v1.MarketDataPrefix, func(k, v []byte) {
+		var md types.MarketData
+		cdc.MustUnmarshal(v, &md)
+		marketDataMoves = append(marketDataMoves, keyMove{k, types.GetMarketDataKey(md.Source, md.Destination), v})
+	})
+
+	// Orders by owner, along with the indices that refer to the owner keys
+	var (
+		ownerMoves []keyMove
+		indexSets  []keyMove
+	)
+	iterate(store, v1.OwnerPrefix, func(k, v []byte) {
+		var order types.Order
+		cdc.MustUnmarshal(v, &order)
+		ownerKey := types.GetOwnerKey(order.Owner, order.ClientOrderID)
+		ownerMoves = append(ownerMoves, keyMove{k, ownerKey, v})
+		indexSets = append(indexSets,
+			keyMove{to: types.GetOrderIDKey(order.ID), value: ownerKey},
+			keyMove{to: types.GetOwnerDenomKey(order.Owner, order.Source.Denom, order.ClientOrderID), value: ownerKey},
+		)
+	})
+
+	// Orders by priority
+	var priorityMoves []keyMove
+	iterate(idxStore, v1.PriorityPrefix, func(k, v []byte) {
+		var order types.Order
+		cdc.MustUnmarshal(v, &order)
+		priorityMoves = append(priorityMoves, keyMove{k, types.GetPriorityKey(order.Source.Denom, order.Destination.Denom, order.Price(), order.PriorityID()), v})
+	})
+
+	moveKeys(idxStore, append(append(marketDataMoves, priorityMoves...), indexSets...))
+	moveKeys(store, ownerMoves)
+
+	return nil
+}
+
+func iterate(store sdk.KVStore, prefix []byte, cb func(k, v []byte)) {
+	it := sdk.KVStorePrefixIterator(store, prefix)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		cb(append([]byte{}, it.Key()...), append([]byte{}, it.Value()...))
+	}
+}
+
+// moveKeys deletes all old keys before writing any new key, as a new key may coincide with an old key of another
+// entry. Moves without an old key only write the new key.
+func moveKeys(store sdk.KVStore, moves []keyMove) {
+	for _, m := range moves {
+		if m.from != nil {
+			store.Delete(m.from)
+		}
+	}
+
+	for _, m := range moves {
+		store.Set(m.to, m.value)
+	}
+}
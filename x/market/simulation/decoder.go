@@ -0,0 +1,89 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's Value to the corresponding market
+// type. The prefixes of the market store and the index store are disjoint, so the decoder is used for both.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch prefix := kvA.Key[:1]; {
+		case bytes.Equal(prefix, types.GetKeysPrefix()):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(prefix, types.GetOwnersPrefix()), bytes.Equal(prefix, types.GetPriorityKeyPrefix()):
+			var orderA, orderB types.Order
+			cdc.MustUnmarshal(kvA.Value, &orderA)
+			cdc.MustUnmarshal(kvB.Value, &orderB)
+			return fmt.Sprintf("%v\n%v", orderA, orderB)
+
+		case bytes.Equal(prefix, types.GetMarketDataPrefix()):
+			var mdA, mdB types.MarketData
+			cdc.MustUnmarshal(kvA.Value, &mdA)
+			cdc.MustUnmarshal(kvB.Value, &mdB)
+			return fmt.Sprintf("%v\n%v", mdA, mdB)
+
+		case bytes.Equal(prefix, types.GetTradePrefix()):
+			var tradeA, tradeB types.Trade
+			cdc.MustUnmarshal(kvA.Value, &tradeA)
+			cdc.MustUnmarshal(kvB.Value, &tradeB)
+			return fmt.Sprintf("%v\n%v", tradeA, tradeB)
+
+		case bytes.Equal(prefix, types.GetCandlePrefix()):
+			var candleA, candleB types.Candle
+			cdc.MustUnmarshal(kvA.Value, &candleA)
+			cdc.MustUnmarshal(kvB.Value, &candleB)
+			return fmt.Sprintf("%v\n%v", candleA, candleB)
+
+		case bytes.Equal(prefix, types.GetConditionalOrderPrefix()):
+			var coA, coB types.ConditionalOrder
+			cdc.MustUnmarshal(kvA.Value, &coA)
+			cdc.MustUnmarshal(kvB.Value, &coB)
+			return fmt.Sprintf("%v\n%v", coA, coB)
+
+		case bytes.Equal(prefix, types.GetOrderHistoryPrefix()):
+			var recordA, recordB types.OrderRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(prefix, types.GetPriceBandPrefix()):
+			var bandA, bandB types.PriceBand
+			cdc.MustUnmarshal(kvA.Value, &bandA)
+			cdc.MustUnmarshal(kvB.Value, &bandB)
+			return fmt.Sprintf("%v\n%v", bandA, bandB)
+
+		case bytes.Equal(prefix, types.GetTradingHaltPrefix()):
+			var haltA, haltB types.TradingHalt
+			cdc.MustUnmarshal(kvA.Value, &haltA)
+			cdc.MustUnmarshal(kvB.Value, &haltB)
+			return fmt.Sprintf("%v\n%v", haltA, haltB)
+
+		case bytes.Equal(prefix, types.GetInstrumentConfigPrefix()):
+			var configA, configB types.InstrumentConfig
+			cdc.MustUnmarshal(kvA.Value, &configA)
+			cdc.MustUnmarshal(kvB.Value, &configB)
+			return fmt.Sprintf("%v\n%v", configA, configB)
+
+		case bytes.Equal(prefix, types.GetExpiryTimePrefix()),
+			bytes.Equal(prefix, types.GetExpiryBlockPrefix()),
+			bytes.Equal(prefix, types.GetTriggerPrefix()),
+			bytes.Equal(prefix, types.GetPendingTriggerPrefix()),
+			bytes.Equal(prefix, types.GetOrderIDPrefix()),
+			bytes.Equal(prefix, types.GetOwnerDenomPrefix()),
+			bytes.Equal(prefix, types.GetPendingBalanceChangePrefix()):
+			// Index entries reference keys of the market store.
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid market key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
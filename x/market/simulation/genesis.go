@@ -0,0 +1,71 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// Simulation parameter constants
+const (
+	MakerFee            = "maker_fee"
+	TakerFee            = "taker_fee"
+	TradeHistoryLength  = "trade_history_length"
+	CandleHistoryLength = "candle_history_length"
+	OrderHistoryLength  = "order_history_length"
+)
+
+// GenFee returns a random fee rate of at most 1%.
+func GenFee(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 4)
+}
+
+// GenHistoryLength returns a random history length, where zero disables the history.
+func GenHistoryLength(r *rand.Rand) uint32 {
+	return uint32(r.Intn(200))
+}
+
+// RandomizedGenState generates a random GenesisState for the market module. The order book starts out empty and is
+// populated by the simulated messages.
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MakerFee, &params.MakerFee, simState.Rand,
+		func(r *rand.Rand) { params.MakerFee = GenFee(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TakerFee, &params.TakerFee, simState.Rand,
+		func(r *rand.Rand) { params.TakerFee = GenFee(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TradeHistoryLength, &params.TradeHistoryLength, simState.Rand,
+		func(r *rand.Rand) { params.TradeHistoryLength = GenHistoryLength(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CandleHistoryLength, &params.CandleHistoryLength, simState.Rand,
+		func(r *rand.Rand) { params.CandleHistoryLength = GenHistoryLength(r) },
+	)
+
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, OrderHistoryLength, &params.OrderHistoryLength, simState.Rand,
+		func(r *rand.Rand) { params.OrderHistoryLength = GenHistoryLength(r) },
+	)
+
+	marketGenesis := types.DefaultGenesisState()
+	marketGenesis.Params = params
+
+	bz, err := json.MarshalIndent(&marketGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated market parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(marketGenesis)
+}
@@ -0,0 +1,576 @@
+package simulation
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/e-money/em-ledger/util"
+	"github.com/e-money/em-ledger/x/market/keeper"
+	"github.com/e-money/em-ledger/x/market/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgAddLimitOrder            = "op_weight_msg_add_limit_order"
+	OpWeightMsgAddMarketOrder           = "op_weight_msg_add_market_order"
+	OpWeightMsgAddSourceMarketOrder     = "op_weight_msg_add_source_market_order"
+	OpWeightMsgCancelOrder              = "op_weight_msg_cancel_order"
+	OpWeightMsgCancelReplaceLimitOrder  = "op_weight_msg_cancel_replace_limit_order"
+	OpWeightMsgCancelReplaceMarketOrder = "op_weight_msg_cancel_replace_market_order"
+	OpWeightMsgBatchOrders              = "op_weight_msg_batch_orders"
+	OpWeightMsgCancelAllOrders          = "op_weight_msg_cancel_all_orders"
+	OpWeightMsgAddConditionalOrder      = "op_weight_msg_add_conditional_order"
+
+	DefaultWeightMsgAddLimitOrder            = 100
+	DefaultWeightMsgAddMarketOrder           = 40
+	DefaultWeightMsgAddSourceMarketOrder     = 20
+	DefaultWeightMsgCancelOrder              = 20
+	DefaultWeightMsgCancelReplaceLimitOrder  = 20
+	DefaultWeightMsgCancelReplaceMarketOrder = 10
+	DefaultWeightMsgBatchOrders              = 20
+	DefaultWeightMsgCancelAllOrders          = 5
+	DefaultWeightMsgAddConditionalOrder      = 20
+)
+
+var (
+	TypeMsgAddLimitOrder            = types.MsgAddLimitOrder{}.Type()
+	TypeMsgAddMarketOrder           = types.MsgAddMarketOrder{}.Type()
+	TypeMsgAddSourceMarketOrder     = types.MsgAddSourceMarketOrder{}.Type()
+	TypeMsgCancelOrder              = types.MsgCancelOrder{}.Type()
+	TypeMsgCancelReplaceLimitOrder  = types.MsgCancelReplaceLimitOrder{}.Type()
+	TypeMsgCancelReplaceMarketOrder = types.MsgCancelReplaceMarketOrder{}.Type()
+	TypeMsgBatchOrders              = types.MsgBatchOrders{}.Type()
+	TypeMsgCancelAllOrders          = types.MsgCancelAllOrders{}.Type()
+	TypeMsgAddConditionalOrder      = types.MsgAddConditionalOrder{}.Type()
+)
+
+// orderRejections are the rejections of random orders that depend on the state of the market rather than on the
+// generated message, such as balances reserved by resting orders, halted instruments and instrument configurations.
+var orderRejections = []error{
+	types.ErrAccountBalanceInsufficient,
+	types.ErrAccountBalanceInsufficientForInstrument,
+	types.ErrPostOnlyWouldMatch,
+	types.ErrTradingHalted,
+	types.ErrPriceOutsideBand,
+	types.ErrNoLiquidity,
+	types.ErrInvalidTickSize,
+	types.ErrInvalidLotSize,
+	types.ErrBelowMinNotional,
+}
+
+// BankKeeper is the bank functionality of the market module, along with what is needed to pay the fees of simulated
+// messages.
+type BankKeeper interface {
+	types.BankKeeper
+	util.SimulationBankKeeper
+}
+
+// WeightedOperations returns all the market module operations with their respective weights.
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak types.AccountKeeper, bk BankKeeper, k *keeper.Keeper,
+) simulation.WeightedOperations {
+	weight := func(key string, defaultWeight int) int {
+		var w int
+		appParams.GetOrGenerate(cdc, key, &w, nil, func(_ *rand.Rand) { w = defaultWeight })
+		return w
+	}
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddLimitOrder, DefaultWeightMsgAddLimitOrder),
+			SimulateMsgAddLimitOrder(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddMarketOrder, DefaultWeightMsgAddMarketOrder),
+			SimulateMsgAddMarketOrder(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddSourceMarketOrder, DefaultWeightMsgAddSourceMarketOrder),
+			SimulateMsgAddSourceMarketOrder(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgCancelOrder, DefaultWeightMsgCancelOrder),
+			SimulateMsgCancelOrder(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgCancelReplaceLimitOrder, DefaultWeightMsgCancelReplaceLimitOrder),
+			SimulateMsgCancelReplaceLimitOrder(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgCancelReplaceMarketOrder, DefaultWeightMsgCancelReplaceMarketOrder),
+			SimulateMsgCancelReplaceMarketOrder(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgBatchOrders, DefaultWeightMsgBatchOrders),
+			SimulateMsgBatchOrders(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgCancelAllOrders, DefaultWeightMsgCancelAllOrders),
+			SimulateMsgCancelAllOrders(ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weight(OpWeightMsgAddConditionalOrder, DefaultWeightMsgAddConditionalOrder),
+			SimulateMsgAddConditionalOrder(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgAddLimitOrder generates a MsgAddLimitOrder priced around the last price of the instrument.
+func SimulateMsgAddLimitOrder(ak types.AccountKeeper, bk BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		msg, ok := randomLimitOrder(r, ctx, bk, k, simAccount.Address)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAddLimitOrder, "no balance to trade"), nil, nil
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, simAccount, &msg, sdk.NewCoins(msg.Source), orderRejections...)
+	}
+}
+
+// SimulateMsgAddMarketOrder generates a MsgAddMarketOrder for an instrument that has been traded.
+func SimulateMsgAddMarketOrder(ak types.AccountKeeper, bk BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		source, ok := randomSource(r, ctx, bk, simAccount.Address)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAddMarketOrder, "no balance to trade"), nil, nil
+		}
+
+		destination, slippage, ok := randomMarketDestination(r, ctx, k, source)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAddMarketOrder, "no market data"), nil, nil
+		}
+
+		msg := &types.MsgAddMarketOrder{
+			Owner:               simAccount.Address.String(),
+			ClientOrderId:       randomClientOrderID(r),
+			TimeInForce:         randomMarketTimeInForce(r),
+			Source:              source.Denom,
+			Destination:         destination,
+			MaxSlippage:         slippage,
+			SelfTradePrevention: randomSelfTradePrevention(r),
+		}
+
+		spent, err := k.GetSrcFromSlippage(ctx, msg.Source, msg.Destination, msg.MaxSlippage)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAddMarketOrder, err.Error()), nil, nil
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(spent), orderRejections...)
+	}
+}
+
+// SimulateMsgAddSourceMarketOrder generates a MsgAddSourceMarketOrder for an instrument with orders in the book.
+func SimulateMsgAddSourceMarketOrder(ak types.AccountKeeper, bk BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		source, ok := randomSource(r, ctx, bk, simAccount.Address)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAddSourceMarketOrder, "no balance to trade"), nil, nil
+		}
+
+		destinations := make([]string, 0)
+		for _, md := range k.GetInstruments(ctx) {
+			if md.Source == source.Denom && k.GetBestPrice(ctx, md.Source, md.Destination) != nil {
+				destinations = append(destinations, md.Destination)
+			}
+		}
+		if len(destinations) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAddSourceMarketOrder, "no liquidity"), nil, nil
+		}
+
+		msg := &types.MsgAddSourceMarketOrder{
+			Owner:               simAccount.Address.String(),
+			ClientOrderId:       randomClientOrderID(r),
+			TimeInForce:         randomMarketTimeInForce(r),
+			Source:              source,
+			Destination:         destinations[r.Intn(len(destinations))],
+			MaxSlippage:         sdk.NewDecWithPrec(int64(r.Intn(21)), 2),
+			SelfTradePrevention: randomSelfTradePrevention(r),
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(msg.Source), orderRejections...)
+	}
+}
+
+// SimulateMsgCancelOrder generates a MsgCancelOrder for a random resting order.
+func SimulateMsgCancelOrder(ak types.AccountKeeper, bk BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		orders := k.GetOrdersByOwner(ctx, simAccount.Address)
+		if len(orders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelOrder, "no orders"), nil, nil
+		}
+
+		msg := &types.MsgCancelOrder{
+			Owner:         simAccount.Address.String(),
+			ClientOrderId: orders[r.Intn(len(orders))].ClientOrderID,
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgCancelReplaceLimitOrder generates a MsgCancelReplaceLimitOrder, which reprices a random resting order.
+func SimulateMsgCancelReplaceLimitOrder(ak types.AccountKeeper, bk BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		orders := k.GetOrdersByOwner(ctx, simAccount.Address)
+		if len(orders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelReplaceLimitOrder, "no orders"), nil, nil
+		}
+
+		msg, ok := randomCancelReplace(r, ctx, bk, simAccount.Address, orders[r.Intn(len(orders))])
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelReplaceLimitOrder, "no balance to trade"), nil, nil
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, simAccount, &msg, sdk.NewCoins(msg.Source), append(orderRejections, types.ErrNoSourceRemaining)...)
+	}
+}
+
+// SimulateMsgCancelReplaceMarketOrder generates a MsgCancelReplaceMarketOrder, which replaces a random resting order
+// with a market order.
+func SimulateMsgCancelReplaceMarketOrder(ak types.AccountKeeper, bk BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		orders := k.GetOrdersByOwner(ctx, simAccount.Address)
+		if len(orders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelReplaceMarketOrder, "no orders"), nil, nil
+		}
+
+		order := orders[r.Intn(len(orders))]
+		balance := bk.SpendableCoins(ctx, simAccount.Address).AmountOf(order.Source.Denom)
+		if !balance.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelReplaceMarketOrder, "no balance to trade"), nil, nil
+		}
+
+		source := sdk.NewCoin(order.Source.Denom, randomAmount(r, balance))
+		destination, slippage, ok := randomMarketDestination(r, ctx, k, source)
+		if !ok || destination.Denom != order.Destination.Denom {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelReplaceMarketOrder, "no market data"), nil, nil
+		}
+
+		msg := &types.MsgCancelReplaceMarketOrder{
+			Owner:               simAccount.Address.String(),
+			OrigClientOrderId:   order.ClientOrderID,
+			NewClientOrderId:    randomClientOrderID(r),
+			TimeInForce:         randomMarketTimeInForce(r),
+			Source:              source.Denom,
+			Destination:         destination,
+			MaxSlippage:         slippage,
+			SelfTradePrevention: randomSelfTradePrevention(r),
+		}
+
+		spent, err := k.GetSrcFromSlippage(ctx, msg.Source, msg.Destination, msg.MaxSlippage)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelReplaceMarketOrder, err.Error()), nil, nil
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(spent), append(orderRejections, types.ErrNoSourceRemaining)...)
+	}
+}
+
+// SimulateMsgBatchOrders generates a MsgBatchOrders that cancels, replaces and adds orders of the same account.
+func SimulateMsgBatchOrders(ak types.AccountKeeper, bk BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		msg := &types.MsgBatchOrders{
+			Owner: simAccount.Address.String(),
+		}
+		spent := sdk.NewCoins()
+
+		orders := k.GetOrdersByOwner(ctx, simAccount.Address)
+		r.Shuffle(len(orders), func(i, j int) { orders[i], orders[j] = orders[j], orders[i] })
+		for _, order := range orders[:r.Intn(len(orders)+1)] {
+			if r.Intn(2) == 0 {
+				msg.Cancels = append(msg.Cancels, types.MsgCancelOrder{ClientOrderId: order.ClientOrderID})
+				continue
+			}
+
+			if item, ok := randomCancelReplace(r, ctx, bk, simAccount.Address, order); ok {
+				item.Owner = ""
+				msg.CancelReplaces = append(msg.CancelReplaces, item)
+				spent = spent.Add(item.Source)
+			}
+		}
+
+		for i := r.Intn(4); i > 0; i-- {
+			if item, ok := randomLimitOrder(r, ctx, bk, k, simAccount.Address); ok {
+				item.Owner = ""
+				msg.LimitOrders = append(msg.LimitOrders, item)
+				spent = spent.Add(item.Source)
+			}
+		}
+
+		itemCount := len(msg.Cancels) + len(msg.CancelReplaces) + len(msg.LimitOrders)
+		if itemCount == 0 || itemCount > types.MaxBatchOrdersItems {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgBatchOrders, "no batch items"), nil, nil
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, simAccount, msg, spent, append(orderRejections, types.ErrNoSourceRemaining)...)
+	}
+}
+
+// SimulateMsgCancelAllOrders generates a MsgCancelAllOrders, which is either unfiltered or filtered by the instrument
+// of a random resting order.
+func SimulateMsgCancelAllOrders(ak types.AccountKeeper, bk BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		orders := k.GetOrdersByOwner(ctx, simAccount.Address)
+		if len(orders) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelAllOrders, "no orders"), nil, nil
+		}
+
+		msg := &types.MsgCancelAllOrders{
+			Owner: simAccount.Address.String(),
+		}
+		if r.Intn(2) == 0 {
+			order := orders[r.Intn(len(orders))]
+			msg.Source, msg.Destination = order.Source.Denom, order.Destination.Denom
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, simAccount, msg, nil)
+	}
+}
+
+// SimulateMsgAddConditionalOrder generates a MsgAddConditionalOrder with a trigger price around the last price of
+// the instrument.
+func SimulateMsgAddConditionalOrder(ak types.AccountKeeper, bk BankKeeper, k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+
+		order, ok := randomLimitOrder(r, ctx, bk, k, simAccount.Address)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgAddConditionalOrder, "no balance to trade"), nil, nil
+		}
+
+		msg := &types.MsgAddConditionalOrder{
+			Owner:               order.Owner,
+			ClientOrderId:       order.ClientOrderId,
+			TimeInForce:         order.TimeInForce,
+			Source:              order.Source,
+			Destination:         order.Destination,
+			GoodTillTime:        order.GoodTillTime,
+			GoodTillBlock:       order.GoodTillBlock,
+			Condition:           types.ConditionType(1 + r.Intn(2)),
+			TriggerPrice:        referencePrice(r, ctx, k, order.Source.Denom, order.Destination.Denom),
+			SelfTradePrevention: order.SelfTradePrevention,
+		}
+
+		return util.DeliverSimulatedMsg(r, app, ctx, ak, bk, simAccount, msg, sdk.NewCoins(msg.Source), append(orderRejections, types.ErrConditionAlreadyMet)...)
+	}
+}
+
+// randomLimitOrder returns a limit order of owner, which sells part of a random balance for a random denomination.
+func randomLimitOrder(
+	r *rand.Rand, ctx sdk.Context, bk BankKeeper, k *keeper.Keeper, owner sdk.AccAddress,
+) (types.MsgAddLimitOrder, bool) {
+	source, ok := randomSource(r, ctx, bk, owner)
+	if !ok {
+		return types.MsgAddLimitOrder{}, false
+	}
+
+	supply, _, err := bk.GetPaginatedTotalSupply(ctx, &query.PageRequest{Limit: math.MaxUint64})
+	if err != nil {
+		panic(err)
+	}
+
+	denoms := make([]string, 0, len(supply))
+	for _, c := range supply {
+		if c.Denom != source.Denom {
+			denoms = append(denoms, c.Denom)
+		}
+	}
+	if len(denoms) == 0 {
+		return types.MsgAddLimitOrder{}, false
+	}
+
+	dstDenom := denoms[r.Intn(len(denoms))]
+	price := referencePrice(r, ctx, k, source.Denom, dstDenom)
+	destination := sdk.NewCoin(dstDenom, source.Amount.ToDec().Mul(price).TruncateInt())
+	if !destination.IsPositive() {
+		return types.MsgAddLimitOrder{}, false
+	}
+
+	msg := types.MsgAddLimitOrder{
+		Owner:               owner.String(),
+		ClientOrderId:       randomClientOrderID(r),
+		Source:              source,
+		Destination:         destination,
+		SelfTradePrevention: randomSelfTradePrevention(r),
+	}
+	msg.TimeInForce, msg.GoodTillTime, msg.GoodTillBlock = randomTimeInForce(r, ctx)
+
+	if isResting(msg.TimeInForce) {
+		if r.Intn(10) == 0 {
+			msg.PostOnly = types.PostOnlyMode(1 + r.Intn(2))
+		}
+
+		if r.Intn(5) == 0 {
+			displaySize := source.Amount.QuoRaw(int64(2 + r.Intn(4)))
+			msg.DisplaySize = &displaySize
+		}
+	}
+
+	return msg, true
+}
+
+// randomCancelReplace returns a cancel-replace of order, which is repriced around its original price.
+func randomCancelReplace(
+	r *rand.Rand, ctx sdk.Context, bk BankKeeper, owner sdk.AccAddress, order *types.Order,
+) (types.MsgCancelReplaceLimitOrder, bool) {
+	balance := bk.SpendableCoins(ctx, owner).AmountOf(order.Source.Denom)
+	if !balance.IsPositive() {
+		return types.MsgCancelReplaceLimitOrder{}, false
+	}
+
+	source := sdk.NewCoin(order.Source.Denom, randomAmount(r, balance))
+	price := order.Price().Mul(randomPriceFactor(r))
+	destination := sdk.NewCoin(order.Destination.Denom, source.Amount.ToDec().Mul(price).TruncateInt())
+	if !destination.IsPositive() {
+		return types.MsgCancelReplaceLimitOrder{}, false
+	}
+
+	msg := types.MsgCancelReplaceLimitOrder{
+		Owner:               owner.String(),
+		OrigClientOrderId:   order.ClientOrderID,
+		NewClientOrderId:    randomClientOrderID(r),
+		Source:              source,
+		Destination:         destination,
+		SelfTradePrevention: randomSelfTradePrevention(r),
+	}
+	msg.TimeInForce, msg.GoodTillTime, msg.GoodTillBlock = randomTimeInForce(r, ctx)
+
+	return msg, true
+}
+
+// randomMarketDestination returns a destination that can be bought with source at the last price and a random
+// slippage.
+func randomMarketDestination(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper, source sdk.Coin) (sdk.Coin, sdk.Dec, bool) {
+	instruments := make([]types.MarketData, 0)
+	for _, md := range k.GetInstruments(ctx) {
+		if md.Source == source.Denom && md.LastPrice != nil && md.LastPrice.IsPositive() {
+			instruments = append(instruments, md)
+		}
+	}
+	if len(instruments) == 0 {
+		return sdk.Coin{}, sdk.Dec{}, false
+	}
+
+	md := instruments[r.Intn(len(instruments))]
+	slippage := sdk.NewDecWithPrec(int64(r.Intn(21)), 2)
+	amount := source.Amount.ToDec().Mul(*md.LastPrice).Quo(sdk.OneDec().Add(slippage)).TruncateInt()
+	if !amount.IsPositive() {
+		return sdk.Coin{}, sdk.Dec{}, false
+	}
+
+	return sdk.NewCoin(md.Destination, amount), slippage, true
+}
+
+// randomSource returns part of a random spendable balance of owner.
+func randomSource(r *rand.Rand, ctx sdk.Context, bk BankKeeper, owner sdk.AccAddress) (sdk.Coin, bool) {
+	spendable := bk.SpendableCoins(ctx, owner)
+	if spendable.Empty() {
+		return sdk.Coin{}, false
+	}
+
+	balance := spendable[r.Intn(len(spendable))]
+	return sdk.NewCoin(balance.Denom, randomAmount(r, balance.Amount)), true
+}
+
+// referencePrice returns a random price around the last price of the instrument, or a random price if it has not
+// been traded.
+func referencePrice(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper, src, dst string) sdk.Dec {
+	if md := k.GetInstrument(ctx, src, dst); md != nil && md.LastPrice != nil {
+		return md.LastPrice.Mul(randomPriceFactor(r))
+	}
+
+	return sdk.NewDecWithPrec(int64(50+r.Intn(151)), 2)
+}
+
+// randomPriceFactor returns a factor between 0.9 and 1.1.
+func randomPriceFactor(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(900+r.Intn(201)), 3)
+}
+
+// randomAmount returns an amount between one and max, which must be positive.
+func randomAmount(r *rand.Rand, max sdk.Int) sdk.Int {
+	amount, err := simtypes.RandPositiveInt(r, max)
+	if err != nil {
+		panic(err)
+	}
+
+	return amount
+}
+
+func randomClientOrderID(r *rand.Rand) string {
+	return simtypes.RandStringOfLength(r, 12)
+}
+
+func randomSelfTradePrevention(r *rand.Rand) types.SelfTradePrevention {
+	return types.SelfTradePrevention(r.Intn(4))
+}
+
+func randomMarketTimeInForce(r *rand.Rand) types.TimeInForce {
+	if r.Intn(2) == 0 {
+		return types.TimeInForce_FillOrKill
+	}
+
+	return types.TimeInForce_ImmediateOrCancel
+}
+
+// randomTimeInForce returns a random time in force with its expiry, where most orders are good till canceled.
+func randomTimeInForce(r *rand.Rand, ctx sdk.Context) (types.TimeInForce, *time.Time, int64) {
+	switch n := r.Intn(10); {
+	case n < 5:
+		return types.TimeInForce_GoodTillCancel, nil, 0
+	case n < 6:
+		return types.TimeInForce_ImmediateOrCancel, nil, 0
+	case n < 7:
+		return types.TimeInForce_FillOrKill, nil, 0
+	case n < 9:
+		goodTillTime := ctx.BlockTime().Add(time.Duration(1+r.Intn(48)) * time.Hour)
+		return types.TimeInForce_GoodTillTime, &goodTillTime, 0
+	default:
+		return types.TimeInForce_GoodTillBlock, nil, ctx.BlockHeight() + 1 + r.Int63n(20)
+	}
+}
+
+func isResting(tif types.TimeInForce) bool {
+	return tif != types.TimeInForce_ImmediateOrCancel && tif != types.TimeInForce_FillOrKill
+}
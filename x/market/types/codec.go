@@ -24,6 +24,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAddMarketOrder{}, "e-money/MsgAddMarketOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelReplaceLimitOrder{}, "e-money/MsgCancelReplaceLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelOrder{}, "e-money/MsgCancelOrder", nil)
+	cdc.RegisterConcrete(&MsgBatchOrders{}, "e-money/MsgBatchOrders", nil)
+	cdc.RegisterConcrete(&MsgCancelAllOrders{}, "e-money/MsgCancelAllOrders", nil)
+	cdc.RegisterConcrete(&MsgAddConditionalOrder{}, "e-money/MsgAddConditionalOrder", nil)
+	cdc.RegisterConcrete(&MsgAddSourceMarketOrder{}, "e-money/MsgAddSourceMarketOrder", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -32,6 +36,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgAddMarketOrder{},
 		&MsgCancelReplaceLimitOrder{},
 		&MsgCancelOrder{},
+		&MsgBatchOrders{},
+		&MsgCancelAllOrders{},
+		&MsgAddConditionalOrder{},
+		&MsgAddSourceMarketOrder{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
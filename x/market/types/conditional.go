@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewConditionalOrder(order Order, condition ConditionType, triggerPrice sdk.Dec) ConditionalOrder {
+	return ConditionalOrder{
+		Order:        order,
+		Condition:    condition,
+		TriggerPrice: triggerPrice,
+	}
+}
+
+func (co ConditionalOrder) Validate() error {
+	if err := co.Order.IsValid(); err != nil {
+		return err
+	}
+
+	return validateCondition(co.Condition, co.TriggerPrice)
+}
+
+// IsTriggeredBy returns whether the last traded price of the order's instrument, stated as destination per source,
+// meets the condition.
+func (co ConditionalOrder) IsTriggeredBy(lastPrice sdk.Dec) bool {
+	switch co.Condition {
+	case ConditionType_StopLoss:
+		return lastPrice.LTE(co.TriggerPrice)
+	case ConditionType_TakeProfit:
+		return lastPrice.GTE(co.TriggerPrice)
+	}
+
+	return false
+}
+
+func validateCondition(condition ConditionType, triggerPrice sdk.Dec) error {
+	switch condition {
+	case ConditionType_StopLoss, ConditionType_TakeProfit:
+	default:
+		return sdkerrors.Wrapf(ErrInvalidConditionalOrder, "Unknown condition: %v", condition)
+	}
+
+	if triggerPrice.IsNil() || !triggerPrice.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidConditionalOrder, "Trigger price must be positive: %v", triggerPrice)
+	}
+
+	return nil
+}
+
+// Convert from the condition string representation to the internal enum type. Case insensitive.
+func ConditionTypeFromString(c string) (ConditionType, error) {
+	c = strings.ToLower(c)
+
+	switch c {
+	case "stop-loss":
+		return ConditionType_StopLoss, nil
+	case "take-profit":
+		return ConditionType_TakeProfit, nil
+	}
+
+	return 0, fmt.Errorf("unknown condition value: %v", c)
+}
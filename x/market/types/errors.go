@@ -15,7 +15,23 @@ var (
 	ErrInvalidPrice                            = sdkerrors.Register(ModuleName, 8, "insufficient source instrument quantity to pay for 1 unit of destination instrument")
 	ErrNoSourceRemaining                       = sdkerrors.Register(ModuleName, 9, "the original order has spent the entire source instrument quantity")
 	ErrUnknownAsset                            = sdkerrors.Register(ModuleName, 10, "unknown destination instrument denomination")
-	ErrUnknownTimeInForce                      = sdkerrors.Register(ModuleName, 12, "unknown time in force value. Valid values are TimeInForce_GoodTillCancel, TimeInForce_FillOrKill, TimeInForce_ImmediateOrCancel")
+	ErrUnknownTimeInForce                      = sdkerrors.Register(ModuleName, 12, "unknown time in force value. Valid values are TimeInForce_GoodTillCancel, TimeInForce_FillOrKill, TimeInForce_ImmediateOrCancel, TimeInForce_GoodTillTime, TimeInForce_GoodTillBlock")
 	ErrNoMarketDataAvailable                   = sdkerrors.Register(ModuleName, 13, "no market data available for instrument")
 	ErrInvalidSlippage                         = sdkerrors.Register(ModuleName, 14, "invalid slippage")
+	ErrInvalidExpiry                           = sdkerrors.Register(ModuleName, 15, "invalid order expiry")
+	ErrInvalidBatch                            = sdkerrors.Register(ModuleName, 16, "invalid batch of orders")
+	ErrInvalidPostOnly                         = sdkerrors.Register(ModuleName, 17, "invalid post-only order")
+	ErrPostOnlyWouldMatch                      = sdkerrors.Register(ModuleName, 18, "post-only order would match immediately")
+	ErrInvalidConditionalOrder                 = sdkerrors.Register(ModuleName, 19, "invalid conditional order")
+	ErrConditionAlreadyMet                     = sdkerrors.Register(ModuleName, 20, "the last traded price already meets the condition")
+	ErrInvalidSelfTradePrevention              = sdkerrors.Register(ModuleName, 21, "invalid self-trade prevention mode")
+	ErrInvalidDisplaySize                      = sdkerrors.Register(ModuleName, 22, "invalid iceberg order display size")
+	ErrTradingHalted                           = sdkerrors.Register(ModuleName, 23, "trading in the instrument is halted")
+	ErrTradingNotHalted                        = sdkerrors.Register(ModuleName, 24, "trading in the instrument is not halted")
+	ErrPriceOutsideBand                        = sdkerrors.Register(ModuleName, 25, "order price is outside of the price band of the instrument")
+	ErrNoLiquidity                             = sdkerrors.Register(ModuleName, 26, "no orders in the book to price the order from")
+	ErrInvalidTickSize                         = sdkerrors.Register(ModuleName, 27, "order price is not a multiple of the tick size of the instrument")
+	ErrInvalidLotSize                          = sdkerrors.Register(ModuleName, 28, "order source amount is not a multiple of the lot size of the instrument")
+	ErrBelowMinNotional                        = sdkerrors.Register(ModuleName, 29, "order destination amount is below the minimum notional of the instrument")
+	ErrInstrumentConfigNotFound                = sdkerrors.Register(ModuleName, 30, "the instrument has no configuration")
 )
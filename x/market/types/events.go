@@ -6,6 +6,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
 )
 
 // market module event types
@@ -24,8 +25,42 @@ const (
 	AttributeKeyDestinationFilled = "destination_filled"
 	AttributeKeyAggressive        = "aggressive"
 	AttributeKeyCreated           = "created"
+	AttributeKeyFee               = "fee"
+	AttributeKeyCondition         = "condition"
+	AttributeKeyTriggerPrice      = "trigger_price"
+	AttributeKeyError             = "error"
+	AttributeKeyReason            = "reason"
+	AttributeKeyAutomatic         = "automatic"
 )
 
+var expireReasonAttributeValues = map[ExpireReason]string{
+	ExpireReason_Filled:              "filled",
+	ExpireReason_Cancelled:           "cancelled",
+	ExpireReason_Replaced:            "replaced",
+	ExpireReason_Killed:              "killed",
+	ExpireReason_InsufficientBalance: "insufficient_balance",
+	ExpireReason_Expired:             "expired",
+	ExpireReason_SelfTradeNewest:     "self_trade_newest",
+	ExpireReason_SelfTradeOldest:     "self_trade_oldest",
+	ExpireReason_PriceBand:           "price_band",
+}
+
+// AttributeValue returns the value of the reason attribute of legacy expire events.
+func (r ExpireReason) AttributeValue() string {
+	if v, found := expireReasonAttributeValues[r]; found {
+		return v
+	}
+
+	return "unspecified"
+}
+
+// emitTypedEvent emits the typed counterpart of a legacy event, which cannot fail for the market event types.
+func emitTypedEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		panic(err)
+	}
+}
+
 func EmitAcceptEvent(ctx sdk.Context, order Order) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(EventTypeMarket,
@@ -38,9 +73,19 @@ func EmitAcceptEvent(ctx sdk.Context, order Order) {
 			sdk.NewAttribute(AttributeKeyCreated, order.Created.Format(time.RFC3339)),
 		),
 	)
+
+	emitTypedEvent(ctx, &EventOrderAccepted{
+		OrderID:       order.ID,
+		Owner:         order.Owner,
+		ClientOrderID: order.ClientOrderID,
+		Source:        order.Source,
+		Destination:   order.Destination,
+		Created:       order.Created,
+	})
 }
 
-func EmitExpireEvent(ctx sdk.Context, order Order) {
+// EmitExpireEvent reports the final state of an order and the reason it was removed from the book.
+func EmitExpireEvent(ctx sdk.Context, order Order, reason ExpireReason) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(EventTypeMarket,
 			sdk.NewAttribute(AttributeKeyAction, "expire"),
@@ -52,11 +97,25 @@ func EmitExpireEvent(ctx sdk.Context, order Order) {
 			sdk.NewAttribute(AttributeKeySourceRemaining, fmt.Sprintf("%v%v", order.SourceRemaining.String(), order.Source.Denom)),
 			sdk.NewAttribute(AttributeKeyDestination, order.Destination.String()),
 			sdk.NewAttribute(AttributeKeyDestinationFilled, fmt.Sprintf("%v%v", order.DestinationFilled.String(), order.Destination.Denom)),
+			sdk.NewAttribute(AttributeKeyReason, reason.AttributeValue()),
 		),
 	)
+
+	emitTypedEvent(ctx, &EventOrderExpired{
+		OrderID:           order.ID,
+		Owner:             order.Owner,
+		ClientOrderID:     order.ClientOrderID,
+		Source:            order.Source,
+		SourceFilled:      sdk.NewCoin(order.Source.Denom, order.SourceFilled),
+		SourceRemaining:   sdk.NewCoin(order.Source.Denom, order.SourceRemaining),
+		Destination:       order.Destination,
+		DestinationFilled: sdk.NewCoin(order.Destination.Denom, order.DestinationFilled),
+		Reason:            reason,
+	})
 }
 
-func EmitFillEvent(ctx sdk.Context, order Order, aggressive bool, sourceFilled sdk.Int, destinationFilled sdk.Int) {
+// EmitFillEvent reports a fill of order, where fee is the part of destinationFilled that was paid as trading fee.
+func EmitFillEvent(ctx sdk.Context, order Order, aggressive bool, sourceFilled sdk.Int, destinationFilled sdk.Int, fee sdk.Int) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(EventTypeMarket,
 			sdk.NewAttribute(AttributeKeyAction, "fill"),
@@ -66,8 +125,19 @@ func EmitFillEvent(ctx sdk.Context, order Order, aggressive bool, sourceFilled s
 			sdk.NewAttribute(AttributeKeyAggressive, strconv.FormatBool(aggressive)),
 			sdk.NewAttribute(AttributeKeySourceFilled, fmt.Sprintf("%v%v", sourceFilled.String(), order.Source.Denom)),
 			sdk.NewAttribute(AttributeKeyDestinationFilled, fmt.Sprintf("%v%v", destinationFilled.String(), order.Destination.Denom)),
+			sdk.NewAttribute(AttributeKeyFee, fmt.Sprintf("%v%v", fee.String(), order.Destination.Denom)),
 		),
 	)
+
+	emitTypedEvent(ctx, &EventOrderFilled{
+		OrderID:           order.ID,
+		Owner:             order.Owner,
+		ClientOrderID:     order.ClientOrderID,
+		Aggressive:        aggressive,
+		SourceFilled:      sdk.NewCoin(order.Source.Denom, sourceFilled),
+		DestinationFilled: sdk.NewCoin(order.Destination.Denom, destinationFilled),
+		Fee:               sdk.NewCoin(order.Destination.Denom, fee),
+	})
 }
 
 func EmitUpdateEvent(ctx sdk.Context, order Order) {
@@ -80,4 +150,99 @@ func EmitUpdateEvent(ctx sdk.Context, order Order) {
 			sdk.NewAttribute(AttributeKeySourceRemaining, fmt.Sprintf("%v%v", order.SourceRemaining.String(), order.Source.Denom)),
 		),
 	)
+
+	emitTypedEvent(ctx, &EventOrderUpdated{
+		OrderID:         order.ID,
+		Owner:           order.Owner,
+		ClientOrderID:   order.ClientOrderID,
+		SourceRemaining: sdk.NewCoin(order.Source.Denom, order.SourceRemaining),
+	})
+}
+
+func EmitConditionalAcceptEvent(ctx sdk.Context, co ConditionalOrder) {
+	emitConditionalEvent(ctx, "accept_conditional", co, nil)
+}
+
+func EmitConditionalCancelEvent(ctx sdk.Context, co ConditionalOrder) {
+	emitConditionalEvent(ctx, "cancel_conditional", co, nil)
+}
+
+// EmitConditionalExpireEvent reports that a conditional order expired before it was triggered.
+func EmitConditionalExpireEvent(ctx sdk.Context, co ConditionalOrder) {
+	emitConditionalEvent(ctx, "expire_conditional", co, nil)
+}
+
+// EmitTriggerEvent reports that a conditional order was triggered, along with the error if the order could not be placed.
+func EmitTriggerEvent(ctx sdk.Context, co ConditionalOrder, err error) {
+	emitConditionalEvent(ctx, "trigger", co, err)
+}
+
+func emitConditionalEvent(ctx sdk.Context, action string, co ConditionalOrder, err error) {
+	var attrs []sdk.Attribute
+	errMsg := ""
+	if err != nil {
+		errMsg = err.Error()
+		attrs = append(attrs, sdk.NewAttribute(AttributeKeyError, errMsg))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(AttributeKeyAction, action),
+				sdk.NewAttribute(AttributeKeyOrderID, fmt.Sprintf("%d", co.Order.ID)),
+				sdk.NewAttribute(AttributeKeyOwner, co.Order.Owner),
+				sdk.NewAttribute(AttributeKeyClientOrderID, co.Order.ClientOrderID),
+				sdk.NewAttribute(AttributeKeySource, co.Order.Source.String()),
+				sdk.NewAttribute(AttributeKeyDestination, co.Order.Destination.String()),
+				sdk.NewAttribute(AttributeKeyCondition, co.Condition.String()),
+				sdk.NewAttribute(AttributeKeyTriggerPrice, co.TriggerPrice.String()),
+			}, attrs...)...,
+		),
+	)
+
+	emitTypedEvent(ctx, &EventConditionalOrder{
+		Action:        action,
+		OrderID:       co.Order.ID,
+		Owner:         co.Order.Owner,
+		ClientOrderID: co.Order.ClientOrderID,
+		Source:        co.Order.Source,
+		Destination:   co.Order.Destination,
+		Condition:     co.Condition,
+		TriggerPrice:  co.TriggerPrice,
+		Error:         errMsg,
+	})
+}
+
+// EmitHaltEvent reports that trading in both directions of an instrument was halted.
+func EmitHaltEvent(ctx sdk.Context, halt TradingHalt) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "halt"),
+			sdk.NewAttribute(AttributeKeySource, halt.Source),
+			sdk.NewAttribute(AttributeKeyDestination, halt.Destination),
+			sdk.NewAttribute(AttributeKeyAutomatic, strconv.FormatBool(halt.Automatic)),
+		),
+	)
+
+	emitTypedEvent(ctx, &EventTradingHalted{
+		Source:      halt.Source,
+		Destination: halt.Destination,
+		Automatic:   halt.Automatic,
+	})
+}
+
+// EmitResumeEvent reports that trading in both directions of an instrument was resumed.
+func EmitResumeEvent(ctx sdk.Context, src, dst string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(EventTypeMarket,
+			sdk.NewAttribute(AttributeKeyAction, "resume"),
+			sdk.NewAttribute(AttributeKeySource, src),
+			sdk.NewAttribute(AttributeKeyDestination, dst),
+		),
+	)
+
+	emitTypedEvent(ctx, &EventTradingResumed{
+		Source:      src,
+		Destination: dst,
+	})
 }
@@ -10,6 +10,7 @@ import (
 type (
 	AccountKeeper interface {
 		GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+		GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
 	}
 
 	BankKeeper interface {
@@ -18,6 +19,6 @@ type (
 		SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 		GetSupply(ctx sdk.Context, denom string) sdk.Coin
 		GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
-		AddBalanceListener(l func(sdk.Context, []sdk.AccAddress))
+		AddBalanceListener(l func(sdk.Context, []sdk.AccAddress, []string))
 	}
 )
@@ -0,0 +1,179 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewGenesisState(orders []Order, marketData []MarketData, nextOrderID uint64, params Params) GenesisState {
+	return GenesisState{
+		Orders:      orders,
+		MarketData:  marketData,
+		NextOrderID: nextOrderID,
+		Params:      params,
+	}
+}
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis verifies that the resting orders and market data are
+// consistent with each other. Whether the orders are covered by the owners'
+// balances can only be determined once the bank state is loaded and is
+// verified during InitGenesis.
+func ValidateGenesis(gs GenesisState) error {
+	if err := gs.Params.Validate(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	type ownerClientID struct {
+		owner, clientOrderID string
+	}
+
+	var (
+		clientOrderIDs = make(map[ownerClientID]bool)
+		orderIDs       = make(map[uint64]bool)
+	)
+
+	for _, order := range gs.Orders {
+		if err := order.IsValid(); err != nil {
+			return fmt.Errorf("order %d is invalid: %w", order.ID, err)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(order.Owner); err != nil {
+			return fmt.Errorf("order %d has an invalid owner %q: %w", order.ID, order.Owner, err)
+		}
+
+		key := ownerClientID{order.Owner, order.ClientOrderID}
+		if clientOrderIDs[key] {
+			return fmt.Errorf("duplicate client order id %q for owner %v", order.ClientOrderID, order.Owner)
+		}
+		clientOrderIDs[key] = true
+
+		if orderIDs[order.ID] {
+			return fmt.Errorf("duplicate order id %d", order.ID)
+		}
+		orderIDs[order.ID] = true
+
+		if order.ID >= gs.NextOrderID {
+			return fmt.Errorf("order id %d is not below the next order id %d", order.ID, gs.NextOrderID)
+		}
+
+		// Replenished iceberg orders draw their priority from the order ids.
+		if order.Priority >= gs.NextOrderID {
+			return fmt.Errorf("order %d has a priority %d that is not below the next order id %d", order.ID, order.Priority, gs.NextOrderID)
+		}
+
+		if order.IsIceberg() && (order.DisplayRemaining == nil || order.DisplayRemaining.IsNegative() || order.DisplayRemaining.GT(*order.DisplaySize)) {
+			return fmt.Errorf("order %d has an invalid display remaining: %v", order.ID, order.DisplayRemaining)
+		}
+
+		if order.SourceRemaining.IsNil() || order.SourceRemaining.IsNegative() {
+			return fmt.Errorf("order %d has a negative source remaining: %v", order.ID, order.SourceRemaining)
+		}
+
+		if order.SourceFilled.IsNil() || order.SourceFilled.IsNegative() {
+			return fmt.Errorf("order %d has a negative source filled: %v", order.ID, order.SourceFilled)
+		}
+
+		if order.SourceFilled.Add(order.SourceRemaining).GT(order.Source.Amount) {
+			return fmt.Errorf("order %d has more source filled and remaining than its source amount: %v", order.ID, order.Source)
+		}
+
+		if order.DestinationFilled.IsNil() || order.DestinationFilled.IsNegative() || order.DestinationFilled.GT(order.Destination.Amount) {
+			return fmt.Errorf("order %d has an invalid destination filled: %v", order.ID, order.DestinationFilled)
+		}
+	}
+
+	for _, co := range gs.ConditionalOrders {
+		order := co.Order
+		if err := co.Validate(); err != nil {
+			return fmt.Errorf("conditional order %d is invalid: %w", order.ID, err)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(order.Owner); err != nil {
+			return fmt.Errorf("conditional order %d has an invalid owner %q: %w", order.ID, order.Owner, err)
+		}
+
+		key := ownerClientID{order.Owner, order.ClientOrderID}
+		if clientOrderIDs[key] {
+			return fmt.Errorf("duplicate client order id %q for owner %v", order.ClientOrderID, order.Owner)
+		}
+		clientOrderIDs[key] = true
+
+		if orderIDs[order.ID] {
+			return fmt.Errorf("duplicate order id %d", order.ID)
+		}
+		orderIDs[order.ID] = true
+
+		if order.ID >= gs.NextOrderID {
+			return fmt.Errorf("conditional order id %d is not below the next order id %d", order.ID, gs.NextOrderID)
+		}
+	}
+
+	instruments := make(map[string]bool)
+	for _, md := range gs.MarketData {
+		if err := sdk.ValidateDenom(md.Source); err != nil {
+			return fmt.Errorf("market data has an invalid source denomination: %w", err)
+		}
+
+		if err := sdk.ValidateDenom(md.Destination); err != nil {
+			return fmt.Errorf("market data has an invalid destination denomination: %w", err)
+		}
+
+		if md.Source == md.Destination {
+			return fmt.Errorf("market data for '%v/%v' is not a valid instrument", md.Source, md.Destination)
+		}
+
+		if md.LastPrice != nil && !md.LastPrice.IsPositive() {
+			return fmt.Errorf("market data for '%v/%v' has a non-positive last price: %v", md.Source, md.Destination, md.LastPrice)
+		}
+
+		key := string(GetMarketDataKey(md.Source, md.Destination))
+		if instruments[key] {
+			return fmt.Errorf("duplicate market data for '%v/%v'", md.Source, md.Destination)
+		}
+		instruments[key] = true
+	}
+
+	halts := make(map[string]bool)
+	for _, halt := range gs.TradingHalts {
+		if err := sdk.ValidateDenom(halt.Source); err != nil {
+			return fmt.Errorf("trading halt has an invalid source denomination: %w", err)
+		}
+
+		if err := sdk.ValidateDenom(halt.Destination); err != nil {
+			return fmt.Errorf("trading halt has an invalid destination denomination: %w", err)
+		}
+
+		if halt.Source == halt.Destination {
+			return fmt.Errorf("trading halt for '%v/%v' is not a valid instrument", halt.Source, halt.Destination)
+		}
+
+		// Halts apply to both directions of an instrument.
+		key := string(GetTradingHaltKey(halt.Source, halt.Destination))
+		if halts[key] {
+			return fmt.Errorf("duplicate trading halt for '%v/%v'", halt.Source, halt.Destination)
+		}
+		halts[key] = true
+	}
+
+	configs := make(map[string]bool)
+	for _, config := range gs.InstrumentConfigs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetInstrumentConfigKey(config.Source, config.Destination))
+		if configs[key] {
+			return fmt.Errorf("duplicate instrument configuration for '%v/%v'", config.Source, config.Destination)
+		}
+		configs[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,680 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: em/market/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type GenesisState struct {
+	Orders            []Order            `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders" yaml:"orders"`
+	MarketData        []MarketData       `protobuf:"bytes,2,rep,name=market_data,json=marketData,proto3" json:"market_data" yaml:"market_data"`
+	NextOrderID       uint64             `protobuf:"varint,3,opt,name=next_order_id,json=nextOrderId,proto3" json:"next_order_id,omitempty" yaml:"next_order_id"`
+	Params            Params             `protobuf:"bytes,4,opt,name=params,proto3" json:"params" yaml:"params"`
+	ConditionalOrders []ConditionalOrder `protobuf:"bytes,5,rep,name=conditional_orders,json=conditionalOrders,proto3" json:"conditional_orders" yaml:"conditional_orders"`
+	TradingHalts      []TradingHalt      `protobuf:"bytes,6,rep,name=trading_halts,json=tradingHalts,proto3" json:"trading_halts" yaml:"trading_halts"`
+	InstrumentConfigs []InstrumentConfig `protobuf:"bytes,7,rep,name=instrument_configs,json=instrumentConfigs,proto3" json:"instrument_configs" yaml:"instrument_configs"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebff68995ee636f7, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetOrders() []Order {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMarketData() []MarketData {
+	if m != nil {
+		return m.MarketData
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextOrderID() uint64 {
+	if m != nil {
+		return m.NextOrderID
+	}
+	return 0
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetConditionalOrders() []ConditionalOrder {
+	if m != nil {
+		return m.ConditionalOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTradingHalts() []TradingHalt {
+	if m != nil {
+		return m.TradingHalts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInstrumentConfigs() []InstrumentConfig {
+	if m != nil {
+		return m.InstrumentConfigs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "em.market.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("em/market/v1/genesis.proto", fileDescriptor_ebff68995ee636f7) }
+
+var fileDescriptor_ebff68995ee636f7 = []byte{
+	// 446 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x1b, 0x56, 0x8a, 0xe4, 0xb4, 0x87, 0x99, 0x22, 0xa5, 0x15, 0x4a, 0x8b, 0x2f, 0x54,
+	0x42, 0x4b, 0xb4, 0x71, 0xe3, 0x98, 0x0e, 0xc1, 0x84, 0xf8, 0xa3, 0x00, 0x17, 0x84, 0x14, 0x79,
+	0x8d, 0xc9, 0x2c, 0x62, 0xbb, 0x8a, 0xdf, 0x4d, 0xed, 0xb7, 0xe0, 0x63, 0xed, 0xb8, 0x23, 0xa7,
+	0x0a, 0xb5, 0xdf, 0x60, 0x77, 0x24, 0x34, 0xdb, 0x74, 0x4b, 0xba, 0x9b, 0xa5, 0xf7, 0xf9, 0xfd,
+	0x5e, 0x3f, 0x96, 0xd1, 0x90, 0x89, 0x58, 0xd0, 0xea, 0x27, 0x83, 0xf8, 0xe2, 0x30, 0x2e, 0x98,
+	0x64, 0x9a, 0xeb, 0x68, 0x5e, 0x29, 0x50, 0xb8, 0xcb, 0x44, 0x64, 0x67, 0xd1, 0xc5, 0xe1, 0xb0,
+	0x5f, 0xa8, 0x42, 0x99, 0x41, 0x7c, 0x73, 0xb2, 0x99, 0xe1, 0xa0, 0xc6, 0xbb, 0xb4, 0x19, 0x91,
+	0xbf, 0x6d, 0xd4, 0x7d, 0x63, 0x85, 0x9f, 0x81, 0x02, 0xc3, 0x09, 0xea, 0xa8, 0x2a, 0x67, 0x95,
+	0x0e, 0xbc, 0xf1, 0xde, 0xc4, 0x3f, 0x7a, 0x1c, 0xdd, 0x5d, 0x10, 0x7d, 0xbc, 0x99, 0x25, 0x4f,
+	0x2e, 0x57, 0xa3, 0xd6, 0xf5, 0x6a, 0xd4, 0x5b, 0x52, 0x51, 0xbe, 0x22, 0x16, 0x20, 0xa9, 0x23,
+	0xf1, 0x57, 0xe4, 0x5b, 0x22, 0xcb, 0x29, 0xd0, 0xe0, 0x81, 0x11, 0x05, 0x75, 0xd1, 0x7b, 0x73,
+	0x3a, 0xa6, 0x40, 0x93, 0xa1, 0xb3, 0x61, 0x6b, 0xbb, 0x83, 0x92, 0x14, 0x89, 0x6d, 0x0e, 0xbf,
+	0x43, 0x3d, 0xc9, 0x16, 0x90, 0x99, 0x2d, 0x19, 0xcf, 0x83, 0xbd, 0xb1, 0x37, 0x69, 0x27, 0xcf,
+	0xd7, 0xab, 0x91, 0xff, 0x81, 0x2d, 0xc0, 0xdc, 0xed, 0xe4, 0xf8, 0x7a, 0x35, 0xea, 0x5b, 0x53,
+	0x2d, 0x4d, 0x52, 0x5f, 0x6e, 0x43, 0x39, 0x9e, 0xa2, 0xce, 0x9c, 0x56, 0x54, 0xe8, 0xa0, 0x3d,
+	0xf6, 0x26, 0xfe, 0x51, 0xbf, 0x7e, 0xbd, 0x4f, 0x66, 0xd6, 0x2c, 0x6a, 0x09, 0x92, 0x3a, 0x14,
+	0xcf, 0x11, 0x9e, 0x29, 0x99, 0x73, 0xe0, 0x4a, 0xd2, 0x32, 0x73, 0x0f, 0xf7, 0xd0, 0xf4, 0x0d,
+	0xeb, 0xc2, 0xe9, 0x6d, 0xce, 0xbe, 0xe1, 0x33, 0xa7, 0x1e, 0x58, 0xf5, 0xae, 0x87, 0xa4, 0xfb,
+	0xb3, 0x06, 0xa4, 0xf1, 0x77, 0xd4, 0x83, 0x8a, 0xe6, 0x5c, 0x16, 0xd9, 0x19, 0x2d, 0x41, 0x07,
+	0x1d, 0xb3, 0x6c, 0x50, 0x5f, 0xf6, 0xc5, 0x46, 0xde, 0xd2, 0x12, 0x92, 0xa7, 0x6e, 0x8f, 0x7b,
+	0x93, 0x1a, 0x4d, 0xd2, 0x2e, 0xdc, 0x46, 0x4d, 0x1f, 0x2e, 0x35, 0x54, 0xe7, 0x82, 0x49, 0xc8,
+	0x66, 0x4a, 0xfe, 0xe0, 0x85, 0x0e, 0x1e, 0xdd, 0xd7, 0xe7, 0x64, 0x9b, 0x9b, 0x9a, 0x58, 0xb3,
+	0xcf, 0xae, 0x87, 0xa4, 0xfb, 0xbc, 0x01, 0xe9, 0xe4, 0xf5, 0xe5, 0x3a, 0xf4, 0xae, 0xd6, 0xa1,
+	0xf7, 0x67, 0x1d, 0x7a, 0xbf, 0x36, 0x61, 0xeb, 0x6a, 0x13, 0xb6, 0x7e, 0x6f, 0xc2, 0xd6, 0xb7,
+	0x17, 0x05, 0x87, 0xb3, 0xf3, 0xd3, 0x68, 0xa6, 0x44, 0xcc, 0x0e, 0x84, 0x92, 0x6c, 0x19, 0x33,
+	0x71, 0x50, 0xb2, 0xbc, 0x60, 0x55, 0xbc, 0xf8, 0xff, 0xa1, 0x61, 0x39, 0x67, 0xfa, 0xb4, 0x63,
+	0x7e, 0xf3, 0xcb, 0x7f, 0x03, 0x00, 0xaf, 0x8c, 0xd9, 0xd4, 0x2a, 0x03, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InstrumentConfigs) > 0 {
+		for iNdEx := len(m.InstrumentConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InstrumentConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TradingHalts) > 0 {
+		for iNdEx := len(m.TradingHalts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TradingHalts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ConditionalOrders) > 0 {
+		for iNdEx := len(m.ConditionalOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConditionalOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.NextOrderID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextOrderID))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MarketData) > 0 {
+		for iNdEx := len(m.MarketData) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarketData[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MarketData) > 0 {
+		for _, e := range m.MarketData {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextOrderID != 0 {
+		n += 1 + sovGenesis(uint64(m.NextOrderID))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ConditionalOrders) > 0 {
+		for _, e := range m.ConditionalOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TradingHalts) > 0 {
+		for _, e := range m.TradingHalts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InstrumentConfigs) > 0 {
+		for _, e := range m.InstrumentConfigs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketData = append(m.MarketData, MarketData{})
+			if err := m.MarketData[len(m.MarketData)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextOrderID", wireType)
+			}
+			m.NextOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionalOrders = append(m.ConditionalOrders, ConditionalOrder{})
+			if err := m.ConditionalOrders[len(m.ConditionalOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingHalts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TradingHalts = append(m.TradingHalts, TradingHalt{})
+			if err := m.TradingHalts[len(m.TradingHalts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstrumentConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstrumentConfigs = append(m.InstrumentConfigs, InstrumentConfig{})
+			if err := m.InstrumentConfigs[len(m.InstrumentConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,188 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	owner1 := sdk.AccAddress([]byte("acc1________________"))
+	owner2 := sdk.AccAddress([]byte("acc2________________"))
+
+	newOrder := func(id uint64, owner sdk.AccAddress, clientOrderID string) Order {
+		o, err := NewOrder(time.Now(), TimeInForce_GoodTillCancel, coin("100eur"), coin("120usd"), owner, clientOrderID)
+		require.NoError(t, err)
+		o.ID = id
+		return o
+	}
+
+	price := sdk.NewDecWithPrec(12, 1)
+	validState := func() GenesisState {
+		gs := NewGenesisState(
+			[]Order{newOrder(0, owner1, "A"), newOrder(1, owner2, "A"), newOrder(2, owner1, "B")},
+			[]MarketData{{Source: "eur", Destination: "usd", LastPrice: &price}, {Source: "usd", Destination: "eur"}},
+			4,
+			DefaultParams(),
+		)
+		gs.ConditionalOrders = []ConditionalOrder{
+			NewConditionalOrder(newOrder(3, owner1, "C"), ConditionType_StopLoss, sdk.OneDec()),
+		}
+		gs.TradingHalts = []TradingHalt{{Source: "eur", Destination: "usd", Halted: time.Now()}}
+		gs.InstrumentConfigs = []InstrumentConfig{
+			NewInstrumentConfig("eur", "usd", sdk.NewDecWithPrec(1, 2), sdk.NewInt(10), sdk.ZeroInt()),
+			NewInstrumentConfig("usd", "eur", sdk.ZeroDec(), sdk.ZeroInt(), sdk.NewInt(100)),
+		}
+		gs.Params.CircuitBreakers = []CircuitBreaker{
+			{Source: "eur", Destination: "usd", MaxDeviation: sdk.NewDecWithPrec(1, 1), Window: time.Hour, MaxBreaches: 3},
+		}
+		return gs
+	}
+
+	require.NoError(t, ValidateGenesis(*DefaultGenesisState()))
+	require.NoError(t, ValidateGenesis(validState()))
+
+	specs := map[string]func(*GenesisState){
+		"duplicate client order id": func(gs *GenesisState) {
+			gs.Orders[2].ClientOrderID = "A"
+		},
+		"duplicate order id": func(gs *GenesisState) {
+			gs.Orders[2].ID = 1
+		},
+		"order id not below next order id": func(gs *GenesisState) {
+			gs.NextOrderID = 2
+		},
+		"invalid owner": func(gs *GenesisState) {
+			gs.Orders[0].Owner = "foo"
+		},
+		"invalid instrument": func(gs *GenesisState) {
+			gs.Orders[0].Destination = coin("120eur")
+		},
+		"negative source remaining": func(gs *GenesisState) {
+			gs.Orders[0].SourceRemaining = sdk.NewInt(-1)
+		},
+		"source remaining exceeds source": func(gs *GenesisState) {
+			gs.Orders[0].SourceFilled = sdk.NewInt(10)
+		},
+		"destination overfilled": func(gs *GenesisState) {
+			gs.Orders[0].DestinationFilled = sdk.NewInt(121)
+		},
+		"duplicate market data": func(gs *GenesisState) {
+			gs.MarketData[1] = gs.MarketData[0]
+		},
+		"invalid market data instrument": func(gs *GenesisState) {
+			gs.MarketData[1].Destination = "usd"
+		},
+		"invalid params": func(gs *GenesisState) {
+			gs.Params.CandleIntervals = []time.Duration{time.Millisecond}
+		},
+		"negative maker fee": func(gs *GenesisState) {
+			gs.Params.MakerFee = sdk.NewDecWithPrec(-1, 3)
+		},
+		"taker fee of one": func(gs *GenesisState) {
+			gs.Params.TakerFee = sdk.OneDec()
+		},
+		"conditional order with duplicate client order id": func(gs *GenesisState) {
+			gs.ConditionalOrders[0].Order.ClientOrderID = "B"
+		},
+		"conditional order with duplicate order id": func(gs *GenesisState) {
+			gs.ConditionalOrders[0].Order.ID = 2
+		},
+		"conditional order id not below next order id": func(gs *GenesisState) {
+			gs.NextOrderID = 3
+			gs.Orders = gs.Orders[:2]
+		},
+		"conditional order without condition": func(gs *GenesisState) {
+			gs.ConditionalOrders[0].Condition = ConditionType_Unspecified
+		},
+		"conditional order with non-positive trigger price": func(gs *GenesisState) {
+			gs.ConditionalOrders[0].TriggerPrice = sdk.ZeroDec()
+		},
+		"duplicate trading halt": func(gs *GenesisState) {
+			gs.TradingHalts = append(gs.TradingHalts, TradingHalt{Source: "usd", Destination: "eur"})
+		},
+		"invalid trading halt instrument": func(gs *GenesisState) {
+			gs.TradingHalts[0].Destination = "eur"
+		},
+		"duplicate circuit breaker": func(gs *GenesisState) {
+			gs.Params.CircuitBreakers = append(gs.Params.CircuitBreakers, gs.Params.CircuitBreakers[0])
+			gs.Params.CircuitBreakers[1].Source, gs.Params.CircuitBreakers[1].Destination = "usd", "eur"
+		},
+		"circuit breaker deviation of one": func(gs *GenesisState) {
+			gs.Params.CircuitBreakers[0].MaxDeviation = sdk.OneDec()
+		},
+		"circuit breaker without deviation": func(gs *GenesisState) {
+			gs.Params.CircuitBreakers[0].MaxDeviation = sdk.ZeroDec()
+		},
+		"circuit breaker without window": func(gs *GenesisState) {
+			gs.Params.CircuitBreakers[0].Window = 0
+		},
+		"duplicate instrument configuration": func(gs *GenesisState) {
+			gs.InstrumentConfigs[1] = gs.InstrumentConfigs[0]
+		},
+		"invalid instrument configuration instrument": func(gs *GenesisState) {
+			gs.InstrumentConfigs[0].Destination = "eur"
+		},
+		"negative tick size": func(gs *GenesisState) {
+			gs.InstrumentConfigs[0].TickSize = sdk.NewDecWithPrec(-1, 2)
+		},
+		"negative minimum notional": func(gs *GenesisState) {
+			gs.InstrumentConfigs[0].MinNotional = sdk.NewInt(-1)
+		},
+		"negative default lot size": func(gs *GenesisState) {
+			gs.Params.LotSize = sdk.NewInt(-1)
+		},
+		"non-positive last price": func(gs *GenesisState) {
+			zero := sdk.ZeroDec()
+			gs.MarketData[1].LastPrice = &zero
+		},
+	}
+
+	for name, mutate := range specs {
+		t.Run(name, func(t *testing.T) {
+			gs := validState()
+			mutate(&gs)
+			require.Error(t, ValidateGenesis(gs))
+		})
+	}
+}
+
+func TestGenesisSerialization(t *testing.T) {
+	price := sdk.NewDecWithPrec(12, 1)
+	tm := time.Now().UTC()
+	o, err := NewOrder(tm, TimeInForce_GoodTillCancel, coin("100eur"), coin("120usd"), []byte("acc1"), "A")
+	require.NoError(t, err)
+	o.ID = 7
+
+	expiry := tm.Add(time.Hour)
+	o2, err := NewOrderWithExpiry(tm, TimeInForce_GoodTillTime, coin("100eur"), coin("120usd"), []byte("acc1"), "B", &expiry, 0)
+	require.NoError(t, err)
+	o2.ID = 8
+
+	params := DefaultParams()
+	params.CircuitBreakers = []CircuitBreaker{
+		{Source: "eur", Destination: "usd", MaxDeviation: sdk.NewDecWithPrec(5, 2), Window: time.Hour, MaxBreaches: 3},
+	}
+
+	gs := NewGenesisState([]Order{o, o2}, []MarketData{{Source: "eur", Destination: "usd", LastPrice: &price, Timestamp: &tm}}, 9, params)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	bz, err := cdc.MarshalJSON(&gs)
+	require.NoError(t, err)
+
+	var gs2 GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &gs2))
+	require.Equal(t, gs.NextOrderID, gs2.NextOrderID)
+	require.Len(t, gs2.Orders, 2)
+	require.Equal(t, o.ID, gs2.Orders[0].ID)
+	require.True(t, o.Created.Equal(gs2.Orders[0].Created))
+	require.Nil(t, gs2.Orders[0].GoodTillTime)
+	require.Equal(t, TimeInForce_GoodTillTime, gs2.Orders[1].TimeInForce)
+	require.True(t, expiry.Equal(*gs2.Orders[1].GoodTillTime))
+	require.Equal(t, gs.MarketData[0].LastPrice, gs2.MarketData[0].LastPrice)
+	require.Equal(t, gs.Params, gs2.Params)
+}
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func NewInstrumentConfig(src, dst string, tickSize sdk.Dec, lotSize, minNotional sdk.Int) InstrumentConfig {
+	return InstrumentConfig{
+		Source:      src,
+		Destination: dst,
+		TickSize:    tickSize,
+		LotSize:     lotSize,
+		MinNotional: minNotional,
+	}
+}
+
+func (c InstrumentConfig) Validate() error {
+	if err := sdk.ValidateDenom(c.Source); err != nil {
+		return fmt.Errorf("instrument configuration has an invalid source denomination: %w", err)
+	}
+
+	if err := sdk.ValidateDenom(c.Destination); err != nil {
+		return fmt.Errorf("instrument configuration has an invalid destination denomination: %w", err)
+	}
+
+	if c.Source == c.Destination {
+		return fmt.Errorf("instrument configuration for '%v/%v' is not a valid instrument", c.Source, c.Destination)
+	}
+
+	if err := validateTickSize(c.TickSize); err != nil {
+		return err
+	}
+
+	if err := validateOrderSize(c.LotSize); err != nil {
+		return err
+	}
+
+	return validateOrderSize(c.MinNotional)
+}
+
+// ValidateOrder verifies that the order meets the minimum notional of the instrument. The tick and lot size only
+// apply to orders that may rest in the book, as only those are added to the priority index.
+func (c InstrumentConfig) ValidateOrder(order Order) error {
+	if c.MinNotional.IsPositive() && order.Destination.Amount.LT(c.MinNotional) {
+		return sdkerrors.Wrapf(ErrBelowMinNotional, "%v is less than %v%v", order.Destination, c.MinNotional, c.Destination)
+	}
+
+	if order.TimeInForce == TimeInForce_ImmediateOrCancel || order.TimeInForce == TimeInForce_FillOrKill {
+		return nil
+	}
+
+	if c.LotSize.IsPositive() && !order.Source.Amount.Mod(c.LotSize).IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidLotSize, "%v is not a multiple of %v%v", order.Source, c.LotSize, c.Source)
+	}
+
+	if c.TickSize.IsPositive() && !isMultipleOfTick(order.Source.Amount, order.Destination.Amount, c.TickSize) {
+		return sdkerrors.Wrapf(ErrInvalidTickSize, "price %v is not a multiple of %v", order.Price(), c.TickSize)
+	}
+
+	return nil
+}
+
+// RoundUpToTick returns the smallest amount of at least dst for which dst/src is a multiple of the tick size.
+func (c InstrumentConfig) RoundUpToTick(src, dst sdk.Int) sdk.Int {
+	if !c.TickSize.IsPositive() {
+		return dst
+	}
+
+	// dst * 10^18 is a multiple of src * tick * 10^18 exactly when dst is a multiple of the step below.
+	denominator := new(big.Int).Mul(src.BigInt(), c.TickSize.BigInt())
+	gcd := new(big.Int).GCD(nil, nil, denominator, sdk.OneDec().BigInt())
+	step := sdk.NewIntFromBigInt(new(big.Int).Quo(denominator, gcd))
+
+	if rem := dst.Mod(step); !rem.IsZero() {
+		dst = dst.Add(step.Sub(rem))
+	}
+
+	return dst
+}
+
+// isMultipleOfTick reports whether dst/src is a multiple of tick. It is compared without rounding the price, i.e.
+// dst * 10^18 must be a multiple of src * tick * 10^18.
+func isMultipleOfTick(src, dst sdk.Int, tick sdk.Dec) bool {
+	numerator := new(big.Int).Mul(dst.BigInt(), sdk.OneDec().BigInt())
+	denominator := new(big.Int).Mul(src.BigInt(), tick.BigInt())
+	return new(big.Int).Mod(numerator, denominator).Sign() == 0
+}
+
+func validateTickSize(i interface{}) error {
+	tickSize, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if tickSize.IsNil() || tickSize.IsNegative() {
+		return fmt.Errorf("tick size must be at least zero: %v", tickSize)
+	}
+
+	return nil
+}
+
+func validateOrderSize(i interface{}) error {
+	size, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if size.IsNil() || size.IsNegative() {
+		return fmt.Errorf("order size must be at least zero: %v", size)
+	}
+
+	return nil
+}
@@ -4,7 +4,8 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
-	"strings"
+	"math"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -24,11 +25,24 @@ const (
 	QueryInstruments = "instruments"
 	QueryInstrument  = "instrument"
 	QueryByAccount   = "account"
+	QueryDepth       = "depth"
+
+	// DefaultDepthLevels is the number of price levels per side returned by the depth query if none is specified.
+	DefaultDepthLevels = 20
+	// MaxDepthLevels is the maximum number of price levels per side returned by the depth query.
+	MaxDepthLevels = 500
+
+	// MaxInstrumentOrders is the maximum number of orders per side returned by the instrument query.
+	MaxInstrumentOrders = 1000
 )
 
 var (
 	// Parameter key for global order IDs
 	globalOrderIDKey = []byte("globalOrderID")
+	// Parameter key prefix for the trade sequence of each pair
+	tradeSequenceKey = []byte("tradeSequence/")
+	// Parameter key prefix for the order history sequence of each account
+	orderHistorySequenceKey = []byte("orderHistorySequence/")
 
 	// IAVL Store prefixes
 	keysPrefix = []byte{0x01}
@@ -36,21 +50,85 @@ var (
 	marketDataPrefix = []byte{0x02}
 	priorityPrefix   = []byte{0x03}
 	ownerPrefix      = []byte{0x04}
+
+	expiryTimePrefix  = []byte{0x05}
+	expiryBlockPrefix = []byte{0x06}
+
+	tradePrefix  = []byte{0x07}
+	candlePrefix = []byte{0x08}
+
+	conditionalOrderPrefix = []byte{0x09}
+	triggerPrefix          = []byte{0x0A}
+	pendingTriggerPrefix   = []byte{0x0B}
+
+	orderIDPrefix      = []byte{0x0C}
+	orderHistoryPrefix = []byte{0x0D}
+
+	priceBandPrefix        = []byte{0x0E}
+	tradingHaltPrefix      = []byte{0x0F}
+	instrumentConfigPrefix = []byte{0x10}
+	ownerDenomPrefix       = []byte{0x11}
+
+	pendingBalanceChangePrefix = []byte{0x12}
 )
 
 /*
  - Priority-prefix: Orders sorted by SRC/DST/Price/orderID
  - Owner-prefix : Order sorted by owner-account/ClientOrderId
  - marketData-Prefix : Last traded price sorted by SRC/DST
+ - expiryTime-Prefix : Owner keys of GoodTillTime orders sorted by expiry time/orderID
+ - expiryBlock-Prefix : Owner keys of GoodTillBlock orders sorted by expiry block/orderID
+ - trade-Prefix : Trades sorted by DENOM1/DENOM2/sequence, with the denominations in lexical order
+ - candle-Prefix : Candles sorted by SRC/DST/interval/start
+ - conditionalOrder-Prefix : Conditional orders sorted by owner-account/ClientOrderId
+ - trigger-Prefix : Conditional order keys sorted by SRC/DST/condition/trigger price/orderID
+ - pendingTrigger-Prefix : Instruments whose last price has triggered conditional orders that are yet to be placed
+ - orderID-Prefix : Owner keys of active orders sorted by orderID
+ - orderHistory-Prefix : Completed orders sorted by owner-account/sequence
+ - priceBand-Prefix : Circuit breaker state sorted by DENOM1/DENOM2, with the denominations in lexical order
+ - tradingHalt-Prefix : Halted instruments sorted by DENOM1/DENOM2, with the denominations in lexical order
+ - instrumentConfig-Prefix : Instrument configurations sorted by SOURCE/DESTINATION
+ - ownerDenom-Prefix : Owner keys of active orders sorted by owner-account/SRC/ClientOrderId
+ - pendingBalanceChange-Prefix : Owners and denominations whose orders are yet to be re-evaluated after a balance change
+
+ Denominations and owners are length-prefixed, as denominations such as IBC vouchers may contain "/".
 */
 
+// lengthPrefix prepends the length of s, so that variable length values can be concatenated unambiguously.
+func lengthPrefix(s string) []byte {
+	if len(s) > math.MaxUint8 {
+		panic(fmt.Sprintf("key component exceeds %v bytes: %v", math.MaxUint8, s))
+	}
+
+	return append([]byte{byte(len(s))}, s...)
+}
+
+// parseLengthPrefix splits a length-prefixed value from the start of key.
+func parseLengthPrefix(key []byte) (value string, remainder []byte, err error) {
+	if len(key) == 0 || len(key) < int(key[0])+1 {
+		return "", nil, fmt.Errorf("invalid length-prefixed key: %v", hex.EncodeToString(key))
+	}
+
+	n := int(key[0]) + 1
+	return string(key[1:n]), key[n:], nil
+}
+
+func instrumentKey(prefix []byte, src, dst string) []byte {
+	res := append([]byte{}, prefix...)
+	res = append(res, lengthPrefix(src)...)
+	return append(res, lengthPrefix(dst)...)
+}
+
 func GetMarketDataPrefix() []byte {
 	return marketDataPrefix
 }
 
 func GetMarketDataKey(src, dst string) []byte {
-	instr := fmt.Sprintf("%v/%v", src, dst)
-	return append(GetMarketDataPrefix(), []byte(instr)...)
+	return instrumentKey(GetMarketDataPrefix(), src, dst)
+}
+
+func GetKeysPrefix() []byte {
+	return keysPrefix
 }
 
 func GetOrderIDGeneratorKey() []byte {
@@ -58,13 +136,11 @@ func GetOrderIDGeneratorKey() []byte {
 }
 
 func GetPriorityKeyBySrcAndDst(src, dst string) []byte {
-	instr := fmt.Sprintf("%v/%v", src, dst)
-	return append(priorityPrefix, []byte(instr)...)
+	return instrumentKey(priorityPrefix, src, dst)
 }
 
 func GetPriorityKeyBySource(src string) []byte {
-	instr := fmt.Sprintf("%v/", src)
-	return append(priorityPrefix, []byte(instr)...)
+	return append(append([]byte{}, priorityPrefix...), lengthPrefix(src)...)
 }
 
 func GetPriorityKeyPrefix() []byte {
@@ -72,8 +148,7 @@ func GetPriorityKeyPrefix() []byte {
 }
 
 func GetPriorityKeyByInstrument(src, dst string) []byte {
-	instr := fmt.Sprintf("%v/%v/", src, dst)
-	return append(priorityPrefix, []byte(instr)...)
+	return GetPriorityKeyBySrcAndDst(src, dst)
 }
 
 func GetPriorityKey(src, dst string, price sdk.Dec, orderId uint64) []byte {
@@ -101,8 +176,17 @@ func ParsePriorityKey(key []byte) (source, destination string, err error) {
 		return "", "", fmt.Errorf("invalid prefix: %v", hex.EncodeToString(key))
 	}
 
-	a := strings.Split(string(key[1:]), "/")
-	return a[0], a[1], nil
+	source, remainder, err := parseLengthPrefix(key[len(priorityPrefix):])
+	if err != nil {
+		return "", "", err
+	}
+
+	destination, _, err = parseLengthPrefix(remainder)
+	if err != nil {
+		return "", "", err
+	}
+
+	return source, destination, nil
 }
 
 func GetOwnersPrefix() []byte {
@@ -110,7 +194,240 @@ func GetOwnersPrefix() []byte {
 }
 
 func GetOwnerKey(acc, clientOrderId string) []byte {
-	res := append(GetOwnersPrefix(), []byte(acc)...)
+	res := append(append([]byte{}, GetOwnersPrefix()...), lengthPrefix(acc)...)
 	res = append(res, []byte(clientOrderId)...)
 	return res
 }
+
+func GetOwnerDenomPrefix() []byte {
+	return ownerDenomPrefix
+}
+
+func GetOwnerDenomKeyPrefix(acc, src string) []byte {
+	res := append(append([]byte{}, ownerDenomPrefix...), lengthPrefix(acc)...)
+	return append(res, lengthPrefix(src)...)
+}
+
+func GetOwnerDenomKey(acc, src, clientOrderId string) []byte {
+	return append(GetOwnerDenomKeyPrefix(acc, src), []byte(clientOrderId)...)
+}
+
+func GetOrderIDPrefix() []byte {
+	return orderIDPrefix
+}
+
+func GetOrderIDKey(orderId uint64) []byte {
+	return append(append([]byte{}, orderIDPrefix...), util.Uint64ToBytes(orderId)...)
+}
+
+func GetOrderHistorySequenceKey(acc string) []byte {
+	res := append(append([]byte{}, keysPrefix...), orderHistorySequenceKey...)
+	return append(res, lengthPrefix(acc)...)
+}
+
+func GetOrderHistoryPrefix() []byte {
+	return orderHistoryPrefix
+}
+
+func GetOrderHistoryKeyPrefix(acc string) []byte {
+	return append(append([]byte{}, orderHistoryPrefix...), lengthPrefix(acc)...)
+}
+
+func GetOrderHistoryKey(acc string, sequence uint64) []byte {
+	return append(GetOrderHistoryKeyPrefix(acc), util.Uint64ToBytes(sequence)...)
+}
+
+func GetExpiryTimePrefix() []byte {
+	return expiryTimePrefix
+}
+
+// GetExpiryTimeKeyPrefix returns the prefix of all orders expiring at the given time.
+func GetExpiryTimeKeyPrefix(expiry time.Time) []byte {
+	return append(GetExpiryTimePrefix(), sdk.FormatTimeBytes(expiry)...)
+}
+
+func GetExpiryTimeKey(expiry time.Time, orderId uint64) []byte {
+	return append(GetExpiryTimeKeyPrefix(expiry), util.Uint64ToBytes(orderId)...)
+}
+
+func GetExpiryBlockPrefix() []byte {
+	return expiryBlockPrefix
+}
+
+// GetExpiryBlockKeyPrefix returns the prefix of all orders expiring after the given block.
+func GetExpiryBlockKeyPrefix(height int64) []byte {
+	return append(GetExpiryBlockPrefix(), util.Uint64ToBytes(uint64(height))...)
+}
+
+func GetExpiryBlockKey(height int64, orderId uint64) []byte {
+	return append(GetExpiryBlockKeyPrefix(height), util.Uint64ToBytes(orderId)...)
+}
+
+// GetExpiryKey returns the expiry index key of an order, or nil if the order does not expire.
+func GetExpiryKey(order *Order) []byte {
+	switch order.TimeInForce {
+	case TimeInForce_GoodTillTime:
+		return GetExpiryTimeKey(*order.GoodTillTime, order.ID)
+	case TimeInForce_GoodTillBlock:
+		return GetExpiryBlockKey(order.GoodTillBlock, order.ID)
+	}
+
+	return nil
+}
+
+// GetTradePair returns the denominations of an instrument in lexical order, as trades are recorded once for both directions.
+func GetTradePair(src, dst string) (denom1, denom2 string) {
+	if src > dst {
+		return dst, src
+	}
+
+	return src, dst
+}
+
+func GetTradeSequenceKey(src, dst string) []byte {
+	denom1, denom2 := GetTradePair(src, dst)
+	return instrumentKey(append(append([]byte{}, keysPrefix...), tradeSequenceKey...), denom1, denom2)
+}
+
+func GetTradePrefix() []byte {
+	return tradePrefix
+}
+
+func GetTradeKeyPrefix(src, dst string) []byte {
+	denom1, denom2 := GetTradePair(src, dst)
+	return instrumentKey(tradePrefix, denom1, denom2)
+}
+
+func GetTradeKey(src, dst string, sequence uint64) []byte {
+	return append(GetTradeKeyPrefix(src, dst), util.Uint64ToBytes(sequence)...)
+}
+
+func GetPriceBandPrefix() []byte {
+	return priceBandPrefix
+}
+
+func GetPriceBandKey(src, dst string) []byte {
+	denom1, denom2 := GetTradePair(src, dst)
+	return instrumentKey(priceBandPrefix, denom1, denom2)
+}
+
+func GetTradingHaltPrefix() []byte {
+	return tradingHaltPrefix
+}
+
+func GetTradingHaltKey(src, dst string) []byte {
+	denom1, denom2 := GetTradePair(src, dst)
+	return instrumentKey(tradingHaltPrefix, denom1, denom2)
+}
+
+func GetInstrumentConfigPrefix() []byte {
+	return instrumentConfigPrefix
+}
+
+func GetInstrumentConfigKey(src, dst string) []byte {
+	return instrumentKey(instrumentConfigPrefix, src, dst)
+}
+
+func GetCandlePrefix() []byte {
+	return candlePrefix
+}
+
+func GetCandleKeyPrefix(src, dst string, interval time.Duration) []byte {
+	res := instrumentKey(candlePrefix, src, dst)
+	return append(res, util.Uint64ToBytes(uint64(interval/time.Second))...)
+}
+
+func GetCandleKey(src, dst string, interval time.Duration, start time.Time) []byte {
+	return append(GetCandleKeyPrefix(src, dst, interval), util.Uint64ToBytes(uint64(start.Unix()))...)
+}
+
+func GetConditionalOrderPrefix() []byte {
+	return conditionalOrderPrefix
+}
+
+func GetConditionalOrderKey(acc, clientOrderId string) []byte {
+	res := append(append([]byte{}, conditionalOrderPrefix...), lengthPrefix(acc)...)
+	return append(res, []byte(clientOrderId)...)
+}
+
+func GetTriggerPrefix() []byte {
+	return triggerPrefix
+}
+
+// GetTriggerKeyPrefix returns the prefix of the conditional orders of an instrument with the given condition, which are
+// sorted by trigger price.
+func GetTriggerKeyPrefix(src, dst string, condition ConditionType) []byte {
+	return append(instrumentKey(triggerPrefix, src, dst), byte(condition))
+}
+
+func GetTriggerPriceKey(src, dst string, condition ConditionType, triggerPrice sdk.Dec) []byte {
+	return append(GetTriggerKeyPrefix(src, dst, condition), sdk.SortableDecBytes(triggerPrice)...)
+}
+
+func GetTriggerKey(order *ConditionalOrder) []byte {
+	res := GetTriggerPriceKey(order.Order.Source.Denom, order.Order.Destination.Denom, order.Condition, order.TriggerPrice)
+	return append(res, util.Uint64ToBytes(order.Order.ID)...)
+}
+
+func GetPendingTriggerPrefix() []byte {
+	return pendingTriggerPrefix
+}
+
+func GetPendingTriggerKey(src, dst string) []byte {
+	return instrumentKey(pendingTriggerPrefix, src, dst)
+}
+
+func MustParsePendingTriggerKey(key []byte) (source, destination string) {
+	src, dest, err := ParsePendingTriggerKey(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return src, dest
+}
+
+func ParsePendingTriggerKey(key []byte) (source, destination string, err error) {
+	if !bytes.HasPrefix(key, pendingTriggerPrefix) {
+		return "", "", fmt.Errorf("invalid prefix: %v", hex.EncodeToString(key))
+	}
+
+	source, remainder, err := parseLengthPrefix(key[len(pendingTriggerPrefix):])
+	if err != nil {
+		return "", "", err
+	}
+
+	destination, _, err = parseLengthPrefix(remainder)
+	return source, destination, err
+}
+
+func GetPendingBalanceChangePrefix() []byte {
+	return pendingBalanceChangePrefix
+}
+
+func GetPendingBalanceChangeKey(acc, denom string) []byte {
+	res := append(append([]byte{}, pendingBalanceChangePrefix...), lengthPrefix(acc)...)
+	return append(res, lengthPrefix(denom)...)
+}
+
+func MustParsePendingBalanceChangeKey(key []byte) (acc, denom string) {
+	acc, denom, err := ParsePendingBalanceChangeKey(key)
+	if err != nil {
+		panic(err)
+	}
+
+	return acc, denom
+}
+
+func ParsePendingBalanceChangeKey(key []byte) (acc, denom string, err error) {
+	if !bytes.HasPrefix(key, pendingBalanceChangePrefix) {
+		return "", "", fmt.Errorf("invalid prefix: %v", hex.EncodeToString(key))
+	}
+
+	acc, remainder, err := parseLengthPrefix(key[len(pendingBalanceChangePrefix):])
+	if err != nil {
+		return "", "", err
+	}
+
+	denom, _, err = parseLengthPrefix(remainder)
+	return acc, denom, err
+}
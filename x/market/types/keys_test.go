@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -22,3 +23,32 @@ func TestParsePriorityKey2(t *testing.T) {
 	_, _, err := ParsePriorityKey(key)
 	require.Error(t, err)
 }
+
+func TestParsePriorityKeyIBCDenom(t *testing.T) {
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	key := GetPriorityKey(ibcDenom, "eur", sdk.NewDec(5), 14)
+
+	src, dst, err := ParsePriorityKey(key)
+	require.NoError(t, err)
+	require.Equal(t, ibcDenom, src)
+	require.Equal(t, "eur", dst)
+
+	_, _, err = ParsePriorityKey(append(GetPriorityKeyPrefix(), 0x10, 'e'))
+	require.Error(t, err)
+}
+
+func TestInstrumentKeysUnambiguous(t *testing.T) {
+	// Denominations containing the former separator produce distinct keys
+	require.NotEqual(t, GetMarketDataKey("ibc/a", "b"), GetMarketDataKey("ibc", "a/b"))
+	require.NotEqual(t, GetTradeSequenceKey("ibc/a", "b"), GetTradeSequenceKey("ibc", "a/b"))
+
+	// Instrument prefixes do not match instruments with longer denominations
+	require.False(t, bytes.HasPrefix(GetPriorityKeyBySrcAndDst("eur", "usdx"), GetPriorityKeyBySrcAndDst("eur", "usd")))
+	require.False(t, bytes.HasPrefix(GetPriorityKeyBySource("eurx"), GetPriorityKeyBySource("eur")))
+	require.True(t, bytes.HasPrefix(GetPriorityKey("eur", "usd", sdk.OneDec(), 1), GetPriorityKeyBySrcAndDst("eur", "usd")))
+	require.True(t, bytes.HasPrefix(GetPriorityKey("eur", "usd", sdk.OneDec(), 1), GetPriorityKeyBySource("eur")))
+
+	// Owner prefixes do not match other owners starting with the same characters
+	require.False(t, bytes.HasPrefix(GetOwnerKey("acc10", "A"), GetOwnerKey("acc1", "")))
+	require.True(t, bytes.HasPrefix(GetOwnerKey("acc1", "A"), GetOwnerKey("acc1", "")))
+}
@@ -10,6 +10,7 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -36,6 +37,8 @@ const (
 	TimeInForce_GoodTillCancel    TimeInForce = 1
 	TimeInForce_ImmediateOrCancel TimeInForce = 2
 	TimeInForce_FillOrKill        TimeInForce = 3
+	TimeInForce_GoodTillTime      TimeInForce = 4
+	TimeInForce_GoodTillBlock     TimeInForce = 5
 )
 
 var TimeInForce_name = map[int32]string{
@@ -43,6 +46,8 @@ var TimeInForce_name = map[int32]string{
 	1: "TIME_IN_FORCE_GOOD_TILL_CANCEL",
 	2: "TIME_IN_FORCE_IMMEDIATE_OR_CANCEL",
 	3: "TIME_IN_FORCE_FILL_OR_KILL",
+	4: "TIME_IN_FORCE_GOOD_TILL_TIME",
+	5: "TIME_IN_FORCE_GOOD_TILL_BLOCK",
 }
 
 var TimeInForce_value = map[string]int32{
@@ -50,6 +55,8 @@ var TimeInForce_value = map[string]int32{
 	"TIME_IN_FORCE_GOOD_TILL_CANCEL":    1,
 	"TIME_IN_FORCE_IMMEDIATE_OR_CANCEL": 2,
 	"TIME_IN_FORCE_FILL_OR_KILL":        3,
+	"TIME_IN_FORCE_GOOD_TILL_TIME":      4,
+	"TIME_IN_FORCE_GOOD_TILL_BLOCK":     5,
 }
 
 func (x TimeInForce) String() string {
@@ -60,6 +67,144 @@ func (TimeInForce) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_888ec7fc0f7580e2, []int{0}
 }
 
+// PostOnlyMode determines how an order that would match immediately is handled
+// when it is required to rest in the order book.
+type PostOnlyMode int32
+
+const (
+	// The order may take liquidity.
+	PostOnlyMode_Disabled PostOnlyMode = 0
+	// The order is rejected if it would match immediately.
+	PostOnlyMode_Reject PostOnlyMode = 1
+	// The order is re-priced to rest just behind the best opposite price.
+	PostOnlyMode_Reprice PostOnlyMode = 2
+)
+
+var PostOnlyMode_name = map[int32]string{
+	0: "POST_ONLY_MODE_DISABLED",
+	1: "POST_ONLY_MODE_REJECT",
+	2: "POST_ONLY_MODE_REPRICE",
+}
+
+var PostOnlyMode_value = map[string]int32{
+	"POST_ONLY_MODE_DISABLED": 0,
+	"POST_ONLY_MODE_REJECT":   1,
+	"POST_ONLY_MODE_REPRICE":  2,
+}
+
+func (x PostOnlyMode) String() string {
+	return proto.EnumName(PostOnlyMode_name, int32(x))
+}
+
+func (PostOnlyMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{1}
+}
+
+// SelfTradePrevention determines how an aggressive order is handled when it
+// would match a passive order of the same owner.
+type SelfTradePrevention int32
+
+const (
+	// The orders are matched.
+	SelfTradePrevention_Disabled SelfTradePrevention = 0
+	// The remainder of the aggressive order is canceled.
+	SelfTradePrevention_CancelNewest SelfTradePrevention = 1
+	// The passive order is canceled and matching continues.
+	SelfTradePrevention_CancelOldest SelfTradePrevention = 2
+	// Both the passive order and the remainder of the aggressive order are
+	// canceled.
+	SelfTradePrevention_CancelBoth SelfTradePrevention = 3
+)
+
+var SelfTradePrevention_name = map[int32]string{
+	0: "SELF_TRADE_PREVENTION_DISABLED",
+	1: "SELF_TRADE_PREVENTION_CANCEL_NEWEST",
+	2: "SELF_TRADE_PREVENTION_CANCEL_OLDEST",
+	3: "SELF_TRADE_PREVENTION_CANCEL_BOTH",
+}
+
+var SelfTradePrevention_value = map[string]int32{
+	"SELF_TRADE_PREVENTION_DISABLED":      0,
+	"SELF_TRADE_PREVENTION_CANCEL_NEWEST": 1,
+	"SELF_TRADE_PREVENTION_CANCEL_OLDEST": 2,
+	"SELF_TRADE_PREVENTION_CANCEL_BOTH":   3,
+}
+
+func (x SelfTradePrevention) String() string {
+	return proto.EnumName(SelfTradePrevention_name, int32(x))
+}
+
+func (SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{2}
+}
+
+// ConditionType determines when a conditional order is triggered by the last
+// traded price of its instrument, stated as destination per source.
+type ConditionType int32
+
+const (
+	ConditionType_Unspecified ConditionType = 0
+	// Triggered when the last price falls to or below the trigger price.
+	ConditionType_StopLoss ConditionType = 1
+	// Triggered when the last price rises to or above the trigger price.
+	ConditionType_TakeProfit ConditionType = 2
+)
+
+var ConditionType_name = map[int32]string{
+	0: "CONDITION_TYPE_UNSPECIFIED",
+	1: "CONDITION_TYPE_STOP_LOSS",
+	2: "CONDITION_TYPE_TAKE_PROFIT",
+}
+
+var ConditionType_value = map[string]int32{
+	"CONDITION_TYPE_UNSPECIFIED": 0,
+	"CONDITION_TYPE_STOP_LOSS":   1,
+	"CONDITION_TYPE_TAKE_PROFIT": 2,
+}
+
+func (x ConditionType) String() string {
+	return proto.EnumName(ConditionType_name, int32(x))
+}
+
+func (ConditionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{3}
+}
+
+// OrderStatus is the final status of an order in the order history.
+type OrderStatus int32
+
+const (
+	OrderStatus_Unspecified OrderStatus = 0
+	// The order was filled completely.
+	OrderStatus_Filled OrderStatus = 1
+	// The order was canceled or replaced by its owner.
+	OrderStatus_Canceled OrderStatus = 2
+	// The order expired or could no longer be funded by its owner.
+	OrderStatus_Expired OrderStatus = 3
+)
+
+var OrderStatus_name = map[int32]string{
+	0: "ORDER_STATUS_UNSPECIFIED",
+	1: "ORDER_STATUS_FILLED",
+	2: "ORDER_STATUS_CANCELED",
+	3: "ORDER_STATUS_EXPIRED",
+}
+
+var OrderStatus_value = map[string]int32{
+	"ORDER_STATUS_UNSPECIFIED": 0,
+	"ORDER_STATUS_FILLED":      1,
+	"ORDER_STATUS_CANCELED":    2,
+	"ORDER_STATUS_EXPIRED":     3,
+}
+
+func (x OrderStatus) String() string {
+	return proto.EnumName(OrderStatus_name, int32(x))
+}
+
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{4}
+}
+
 type Instrument struct {
 	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
 	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
@@ -113,16 +258,28 @@ func (m *Instrument) GetDestination() string {
 }
 
 type Order struct {
-	ID                uint64                                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
-	TimeInForce       TimeInForce                            `protobuf:"varint,2,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
-	Owner             string                                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
-	ClientOrderID     string                                 `protobuf:"bytes,4,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
-	Source            types.Coin                             `protobuf:"bytes,5,opt,name=source,proto3" json:"source" yaml:"source"`
-	SourceRemaining   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=source_remaining,json=sourceRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"source_remaining" yaml:"source_remaining"`
-	SourceFilled      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=source_filled,json=sourceFilled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"source_filled" yaml:"source_filled"`
-	Destination       types.Coin                             `protobuf:"bytes,8,opt,name=destination,proto3" json:"destination" yaml:"destination"`
-	DestinationFilled github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=destination_filled,json=destinationFilled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"destination_filled" yaml:"destination_filled"`
-	Created           time.Time                              `protobuf:"bytes,10,opt,name=created,proto3,stdtime" json:"created" yaml:"created"`
+	ID                  uint64                                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+	TimeInForce         TimeInForce                            `protobuf:"varint,2,opt,name=time_in_force,json=timeInForce,proto3,enum=em.market.v1.TimeInForce" json:"time_in_force,omitempty" yaml:"time_in_force"`
+	Owner               string                                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ClientOrderID       string                                 `protobuf:"bytes,4,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
+	Source              types.Coin                             `protobuf:"bytes,5,opt,name=source,proto3" json:"source" yaml:"source"`
+	SourceRemaining     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=source_remaining,json=sourceRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"source_remaining" yaml:"source_remaining"`
+	SourceFilled        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=source_filled,json=sourceFilled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"source_filled" yaml:"source_filled"`
+	Destination         types.Coin                             `protobuf:"bytes,8,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	DestinationFilled   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=destination_filled,json=destinationFilled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"destination_filled" yaml:"destination_filled"`
+	Created             time.Time                              `protobuf:"bytes,10,opt,name=created,proto3,stdtime" json:"created" yaml:"created"`
+	GoodTillTime        *time.Time                             `protobuf:"bytes,11,opt,name=good_till_time,json=goodTillTime,proto3,stdtime" json:"good_till_time,omitempty" yaml:"good_till_time"`
+	GoodTillBlock       int64                                  `protobuf:"varint,12,opt,name=good_till_block,json=goodTillBlock,proto3" json:"good_till_block,omitempty" yaml:"good_till_block"`
+	PostOnly            PostOnlyMode                           `protobuf:"varint,13,opt,name=post_only,json=postOnly,proto3,enum=em.market.v1.PostOnlyMode" json:"post_only,omitempty" yaml:"post_only"`
+	SelfTradePrevention SelfTradePrevention                    `protobuf:"varint,14,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=em.market.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty" yaml:"self_trade_prevention"`
+	// The source amount displayed in the book at a time, if the order is an
+	// iceberg order. Zero for regular orders.
+	DisplaySize *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,15,opt,name=display_size,json=displaySize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"display_size,omitempty" yaml:"display_size"`
+	// The unfilled part of the displayed slice of an iceberg order.
+	DisplayRemaining *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=display_remaining,json=displayRemaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"display_remaining,omitempty" yaml:"display_remaining"`
+	// Orders the order among the orders with the same price. The order ID is
+	// used while zero, i.e. until an iceberg order is replenished.
+	Priority uint64 `protobuf:"varint,17,opt,name=priority,proto3" json:"priority,omitempty" yaml:"priority"`
 }
 
 func (m *Order) Reset()      { *m = Order{} }
@@ -206,16 +363,106 @@ func (m *Order) GetCreated() time.Time {
 	return time.Time{}
 }
 
+func (m *Order) GetGoodTillTime() *time.Time {
+	if m != nil {
+		return m.GoodTillTime
+	}
+	return nil
+}
+
+func (m *Order) GetGoodTillBlock() int64 {
+	if m != nil {
+		return m.GoodTillBlock
+	}
+	return 0
+}
+
+func (m *Order) GetPostOnly() PostOnlyMode {
+	if m != nil {
+		return m.PostOnly
+	}
+	return PostOnlyMode_Disabled
+}
+
+func (m *Order) GetSelfTradePrevention() SelfTradePrevention {
+	if m != nil {
+		return m.SelfTradePrevention
+	}
+	return SelfTradePrevention_Disabled
+}
+
+func (m *Order) GetPriority() uint64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+// ConditionalOrder rests outside the order book until the last traded price of
+// its instrument crosses the trigger price, at which point the order is placed.
+type ConditionalOrder struct {
+	Order        Order                                  `protobuf:"bytes,1,opt,name=order,proto3" json:"order" yaml:"order"`
+	Condition    ConditionType                          `protobuf:"varint,2,opt,name=condition,proto3,enum=em.market.v1.ConditionType" json:"condition,omitempty" yaml:"condition"`
+	TriggerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price" yaml:"trigger_price"`
+}
+
+func (m *ConditionalOrder) Reset()         { *m = ConditionalOrder{} }
+func (m *ConditionalOrder) String() string { return proto.CompactTextString(m) }
+func (*ConditionalOrder) ProtoMessage()    {}
+func (*ConditionalOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{2}
+}
+func (m *ConditionalOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConditionalOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConditionalOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConditionalOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionalOrder.Merge(m, src)
+}
+func (m *ConditionalOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConditionalOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionalOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConditionalOrder proto.InternalMessageInfo
+
+func (m *ConditionalOrder) GetOrder() Order {
+	if m != nil {
+		return m.Order
+	}
+	return Order{}
+}
+
+func (m *ConditionalOrder) GetCondition() ConditionType {
+	if m != nil {
+		return m.Condition
+	}
+	return ConditionType_Unspecified
+}
+
 type ExecutionPlan struct {
-	Price       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
-	FirstOrder  *Order                                 `protobuf:"bytes,2,opt,name=first_order,json=firstOrder,proto3" json:"first_order,omitempty"`
-	SecondOrder *Order                                 `protobuf:"bytes,3,opt,name=second_order,json=secondOrder,proto3" json:"second_order,omitempty"`
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price"`
+	// Passive orders in the order the source tokens pass through them.
+	Orders []*Order `protobuf:"bytes,4,rep,name=orders,proto3" json:"orders,omitempty"`
 }
 
 func (m *ExecutionPlan) Reset()      { *m = ExecutionPlan{} }
 func (*ExecutionPlan) ProtoMessage() {}
 func (*ExecutionPlan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_888ec7fc0f7580e2, []int{2}
+	return fileDescriptor_888ec7fc0f7580e2, []int{3}
 }
 func (m *ExecutionPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -244,16 +491,9 @@ func (m *ExecutionPlan) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecutionPlan proto.InternalMessageInfo
 
-func (m *ExecutionPlan) GetFirstOrder() *Order {
-	if m != nil {
-		return m.FirstOrder
-	}
-	return nil
-}
-
-func (m *ExecutionPlan) GetSecondOrder() *Order {
+func (m *ExecutionPlan) GetOrders() []*Order {
 	if m != nil {
-		return m.SecondOrder
+		return m.Orders
 	}
 	return nil
 }
@@ -269,7 +509,7 @@ func (m *MarketData) Reset()         { *m = MarketData{} }
 func (m *MarketData) String() string { return proto.CompactTextString(m) }
 func (*MarketData) ProtoMessage()    {}
 func (*MarketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_888ec7fc0f7580e2, []int{3}
+	return fileDescriptor_888ec7fc0f7580e2, []int{4}
 }
 func (m *MarketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,448 +559,4265 @@ func (m *MarketData) GetTimestamp() *time.Time {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("em.market.v1.TimeInForce", TimeInForce_name, TimeInForce_value)
-	proto.RegisterType((*Instrument)(nil), "em.market.v1.Instrument")
-	proto.RegisterType((*Order)(nil), "em.market.v1.Order")
-	proto.RegisterType((*ExecutionPlan)(nil), "em.market.v1.ExecutionPlan")
-	proto.RegisterType((*MarketData)(nil), "em.market.v1.MarketData")
+type Params struct {
+	// Maximum number of trades kept per instrument.
+	TradeHistoryLength uint32 `protobuf:"varint,1,opt,name=trade_history_length,json=tradeHistoryLength,proto3" json:"trade_history_length,omitempty" yaml:"trade_history_length"`
+	// Intervals for which OHLCV candles are maintained.
+	CandleIntervals []time.Duration `protobuf:"bytes,2,rep,name=candle_intervals,json=candleIntervals,proto3,stdduration" json:"candle_intervals" yaml:"candle_intervals"`
+	// Maximum number of candles kept per instrument and interval.
+	CandleHistoryLength uint32 `protobuf:"varint,3,opt,name=candle_history_length,json=candleHistoryLength,proto3" json:"candle_history_length,omitempty" yaml:"candle_history_length"`
+	// Fee rate charged on the amount received by passive orders.
+	MakerFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=maker_fee,json=makerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maker_fee" yaml:"maker_fee"`
+	// Fee rate charged on the amount received by aggressive orders.
+	TakerFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"taker_fee" yaml:"taker_fee"`
+	// Maximum number of completed orders kept per account.
+	OrderHistoryLength uint32 `protobuf:"varint,6,opt,name=order_history_length,json=orderHistoryLength,proto3" json:"order_history_length,omitempty" yaml:"order_history_length"`
+	// Price bands of the instruments with a circuit breaker.
+	CircuitBreakers []CircuitBreaker `protobuf:"bytes,7,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers" yaml:"circuit_breakers"`
+	// Tick size of the instruments without an instrument configuration.
+	TickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size" yaml:"tick_size"`
+	// Lot size of the instruments without an instrument configuration.
+	LotSize github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=lot_size,json=lotSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lot_size" yaml:"lot_size"`
+	// Minimum notional of the instruments without an instrument configuration.
+	MinNotional github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=min_notional,json=minNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_notional" yaml:"min_notional"`
 }
 
-func init() { proto.RegisterFile("em/market/v1/market.proto", fileDescriptor_888ec7fc0f7580e2) }
-
-var fileDescriptor_888ec7fc0f7580e2 = []byte{
-	// 896 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x3f, 0x6f, 0xdb, 0x46,
-	0x14, 0x17, 0x6d, 0xd9, 0x8e, 0x4f, 0x96, 0xad, 0x5c, 0x9d, 0x54, 0xe2, 0x40, 0xaa, 0x1c, 0x82,
-	0x20, 0x81, 0x49, 0xd8, 0x0d, 0x32, 0x04, 0x45, 0x81, 0x48, 0xa2, 0x52, 0xc2, 0xb2, 0x65, 0x30,
-	0x0a, 0x02, 0x74, 0x21, 0x28, 0xf2, 0xa4, 0x1e, 0x4c, 0xde, 0x09, 0xe4, 0xc9, 0x8d, 0xfb, 0x11,
-	0x3c, 0x65, 0xec, 0x62, 0xa0, 0x43, 0x87, 0x7e, 0x94, 0x74, 0x73, 0xb7, 0xa2, 0x03, 0x5b, 0xc8,
-	0x53, 0x87, 0x2e, 0xfa, 0x04, 0x05, 0xef, 0x28, 0x99, 0x6c, 0xd1, 0x16, 0xea, 0xa4, 0x7b, 0x7f,
-	0x7e, 0xef, 0xbd, 0xdf, 0xfb, 0x43, 0x81, 0x06, 0x0a, 0x8d, 0xd0, 0x8d, 0xce, 0x11, 0x33, 0x2e,
-	0x0e, 0xb3, 0x97, 0x3e, 0x89, 0x28, 0xa3, 0x70, 0x07, 0x85, 0x7a, 0xa6, 0xb8, 0x38, 0x94, 0xf7,
-	0xc7, 0x74, 0x4c, 0xb9, 0xc1, 0x48, 0x5f, 0xc2, 0x47, 0x56, 0xc7, 0x94, 0x8e, 0x03, 0x64, 0x70,
-	0x69, 0x38, 0x1d, 0x19, 0x0c, 0x87, 0x28, 0x66, 0x6e, 0x38, 0xc9, 0x1c, 0x14, 0x8f, 0xc6, 0x21,
-	0x8d, 0x8d, 0xa1, 0x1b, 0x23, 0xe3, 0xe2, 0x70, 0x88, 0x98, 0x7b, 0x68, 0x78, 0x14, 0x13, 0x61,
-	0xd7, 0xba, 0x00, 0x58, 0x24, 0x66, 0xd1, 0x34, 0x44, 0x84, 0xc1, 0x87, 0x60, 0x33, 0xa6, 0xd3,
-	0xc8, 0x43, 0x75, 0xa9, 0x29, 0x3d, 0xde, 0xb6, 0x33, 0x09, 0x36, 0x41, 0xc5, 0x47, 0x31, 0xc3,
-	0xc4, 0x65, 0x98, 0x92, 0xfa, 0x1a, 0x37, 0xe6, 0x55, 0xda, 0x1f, 0x9b, 0x60, 0xa3, 0x1f, 0xf9,
-	0x28, 0x82, 0xcf, 0xc0, 0x3d, 0x9a, 0x3e, 0x1c, 0xec, 0xf3, 0x28, 0xe5, 0x56, 0x63, 0x96, 0xa8,
-	0x6b, 0x56, 0x67, 0x9e, 0xa8, 0x7b, 0x97, 0x6e, 0x18, 0xbc, 0xd0, 0x16, 0x76, 0xcd, 0xde, 0xe2,
-	0x4f, 0xcb, 0x87, 0x6f, 0x41, 0x35, 0x2d, 0xdd, 0xc1, 0xc4, 0x19, 0xd1, 0xb4, 0x80, 0x34, 0xc7,
-	0xee, 0x51, 0x43, 0xcf, 0x37, 0x41, 0x1f, 0xe0, 0x10, 0x59, 0xa4, 0x9b, 0x3a, 0xb4, 0xea, 0xf3,
-	0x44, 0xdd, 0x17, 0xf1, 0x0a, 0x48, 0xcd, 0xae, 0xb0, 0x3b, 0x37, 0xf8, 0x08, 0x6c, 0xd0, 0xaf,
-	0x09, 0x8a, 0xea, 0xeb, 0x69, 0xd1, 0xad, 0xda, 0x3c, 0x51, 0x77, 0xb2, 0x2a, 0x52, 0xb5, 0x66,
-	0x0b, 0x33, 0x7c, 0x0d, 0xf6, 0xbc, 0x00, 0x23, 0xc2, 0x9c, 0x65, 0xf5, 0x65, 0x8e, 0x78, 0x3a,
-	0x4b, 0xd4, 0x6a, 0x9b, 0x9b, 0x38, 0x41, 0x4e, 0xe4, 0xa1, 0x08, 0xf1, 0x17, 0x84, 0x66, 0x57,
-	0xbd, 0x9c, 0xa3, 0x0f, 0xbf, 0x58, 0xf6, 0x73, 0xa3, 0x29, 0x3d, 0xae, 0x1c, 0x35, 0x74, 0x31,
-	0x0e, 0x3d, 0x1d, 0x87, 0x9e, 0x8d, 0x43, 0x6f, 0x53, 0x4c, 0x5a, 0x0f, 0x3e, 0x24, 0x6a, 0x69,
-	0x9e, 0xa8, 0x55, 0x11, 0x59, 0xc0, 0xb4, 0xe5, 0x04, 0x18, 0xa8, 0x89, 0x97, 0x13, 0xa1, 0xd0,
-	0xc5, 0x04, 0x93, 0x71, 0x7d, 0x93, 0xd7, 0x67, 0xa5, 0xc0, 0x5f, 0x12, 0xf5, 0xd1, 0x18, 0xb3,
-	0xaf, 0xa6, 0x43, 0xdd, 0xa3, 0xa1, 0x91, 0x0d, 0x5d, 0xfc, 0x1c, 0xc4, 0xfe, 0xb9, 0xc1, 0x2e,
-	0x27, 0x28, 0xd6, 0x2d, 0xc2, 0xe6, 0x89, 0xfa, 0x71, 0x3e, 0xc5, 0x5d, 0x3c, 0xcd, 0xde, 0x13,
-	0x2a, 0x7b, 0xa1, 0x81, 0xe7, 0xa0, 0x9a, 0x79, 0x8d, 0x70, 0x10, 0x20, 0xbf, 0xbe, 0xc5, 0x53,
-	0x76, 0x57, 0x4e, 0xb9, 0x5f, 0x48, 0x29, 0x82, 0x69, 0xf6, 0x8e, 0x90, 0xbb, 0x5c, 0x84, 0x6f,
-	0x8b, 0x4b, 0x76, 0xef, 0xbf, 0x3a, 0x26, 0x67, 0x1d, 0x83, 0x22, 0x76, 0x7e, 0x1b, 0x0b, 0xbb,
-	0x09, 0xbf, 0x01, 0x30, 0x27, 0x2e, 0xa8, 0x6c, 0x73, 0x2a, 0xc7, 0x2b, 0x53, 0x69, 0xfc, 0x2d,
-	0xdd, 0x92, 0xcf, 0xfd, 0x9c, 0x32, 0x23, 0x75, 0x06, 0xb6, 0xbc, 0x08, 0xb9, 0x0c, 0xf9, 0x75,
-	0xc0, 0x09, 0xc9, 0xba, 0x38, 0x59, 0x7d, 0x71, 0xb2, 0x7c, 0xa9, 0xf9, 0xc9, 0x2e, 0x19, 0xed,
-	0x66, 0xdb, 0x25, 0x80, 0xda, 0xfb, 0x5f, 0x55, 0xc9, 0x5e, 0x84, 0x79, 0x51, 0xfe, 0xf6, 0x3b,
-	0xb5, 0xa4, 0xfd, 0x28, 0x81, 0xaa, 0xf9, 0x0e, 0x79, 0xd3, 0x34, 0xd7, 0x59, 0xe0, 0x12, 0xd8,
-	0x01, 0x1b, 0x93, 0x08, 0x2f, 0x4e, 0xb7, 0xa5, 0xaf, 0x40, 0xac, 0x83, 0x3c, 0x5b, 0x80, 0xe1,
-	0x33, 0x50, 0x19, 0xe1, 0x28, 0xce, 0x76, 0x9a, 0x5f, 0x61, 0xe5, 0xe8, 0xa3, 0xe2, 0x15, 0xf2,
-	0xed, 0xb6, 0x01, 0xf7, 0x13, 0x37, 0xff, 0x1c, 0xec, 0xc4, 0xc8, 0xa3, 0xc4, 0xcf, 0x60, 0xeb,
-	0xff, 0x0c, 0xab, 0x08, 0x47, 0x2e, 0x64, 0x5c, 0x7e, 0x92, 0x00, 0x38, 0xe1, 0x6e, 0x1d, 0x97,
-	0xb9, 0xff, 0xff, 0x23, 0x04, 0x2d, 0x00, 0x02, 0x37, 0x66, 0x8e, 0xe8, 0x83, 0x38, 0xf8, 0x27,
-	0x2b, 0xf4, 0x60, 0x3b, 0x45, 0x9f, 0xf1, 0x3e, 0x7c, 0x0e, 0xb6, 0x97, 0x9f, 0x52, 0xfe, 0x21,
-	0xf8, 0xf7, 0xc9, 0x95, 0xf9, 0x8c, 0xee, 0x20, 0x4f, 0x7e, 0x97, 0x40, 0x25, 0xf7, 0xb5, 0x82,
-	0x3a, 0x68, 0x0c, 0xac, 0x13, 0xd3, 0xb1, 0x4e, 0x9d, 0x6e, 0xdf, 0x6e, 0x9b, 0xce, 0x9b, 0xd3,
-	0xd7, 0x67, 0x66, 0xdb, 0xea, 0x5a, 0x66, 0xa7, 0x56, 0x92, 0xf7, 0xae, 0xae, 0x9b, 0x95, 0x37,
-	0x24, 0x9e, 0x20, 0x0f, 0x8f, 0x30, 0xf2, 0xe1, 0x73, 0xa0, 0x14, 0xfd, 0x5f, 0xf5, 0xfb, 0x1d,
-	0x67, 0x60, 0xf5, 0x7a, 0x4e, 0xfb, 0xe5, 0x69, 0xdb, 0xec, 0xd5, 0x24, 0x19, 0x5e, 0x5d, 0x37,
-	0x77, 0x5f, 0x51, 0xea, 0x0f, 0x70, 0x10, 0xb4, 0x5d, 0xe2, 0xa1, 0x00, 0x7e, 0x06, 0x3e, 0x29,
-	0xe2, 0xac, 0x93, 0x13, 0xb3, 0x63, 0xbd, 0x1c, 0x98, 0x4e, 0xdf, 0x5e, 0x40, 0xd7, 0xe4, 0x07,
-	0x57, 0xd7, 0xcd, 0xfb, 0x56, 0x18, 0x22, 0x1f, 0xbb, 0x0c, 0xf5, 0xa3, 0x0c, 0xad, 0x03, 0xb9,
-	0x88, 0xee, 0xa6, 0x09, 0xfb, 0xb6, 0x73, 0x6c, 0xf5, 0x7a, 0xb5, 0x75, 0x79, 0xf7, 0xea, 0xba,
-	0x09, 0xd2, 0xcd, 0xee, 0x47, 0xc7, 0x38, 0x08, 0xe4, 0xf2, 0x0f, 0xdf, 0x2b, 0x52, 0xcb, 0xfc,
-	0x30, 0x53, 0xa4, 0x9b, 0x99, 0x22, 0xfd, 0x36, 0x53, 0xa4, 0xf7, 0xb7, 0x4a, 0xe9, 0xe6, 0x56,
-	0x29, 0xfd, 0x7c, 0xab, 0x94, 0xbe, 0x7c, 0x9a, 0x6b, 0x3c, 0x3a, 0x08, 0x29, 0x41, 0x97, 0x06,
-	0x0a, 0x0f, 0x02, 0xe4, 0x8f, 0x51, 0x64, 0xbc, 0x5b, 0xfc, 0xf3, 0xf1, 0x09, 0x0c, 0x37, 0x79,
-	0x5f, 0x3f, 0xfd, 0x33, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x22, 0xa6, 0x88, 0x13, 0x07, 0x00, 0x00,
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{5}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
 }
 
-func (m *Instrument) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetTradeHistoryLength() uint32 {
+	if m != nil {
+		return m.TradeHistoryLength
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Instrument) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetCandleIntervals() []time.Duration {
+	if m != nil {
+		return m.CandleIntervals
+	}
+	return nil
 }
 
-func (m *Instrument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
-		i--
-		dAtA[i] = 0x12
+func (m *Params) GetCandleHistoryLength() uint32 {
+	if m != nil {
+		return m.CandleHistoryLength
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *Params) GetOrderHistoryLength() uint32 {
+	if m != nil {
+		return m.OrderHistoryLength
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Order) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Order) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// InstrumentConfig restricts the orders that sell source for destination. A
+// zero value leaves the respective property of the orders unrestricted.
+type InstrumentConfig struct {
+	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	// Orders that may rest in the book are priced at a multiple of the tick
+	// size, in destination per source.
+	TickSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=tick_size,json=tickSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tick_size" yaml:"tick_size"`
+	// Orders that may rest in the book sell a multiple of the lot size.
+	LotSize github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=lot_size,json=lotSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lot_size" yaml:"lot_size"`
+	// Smallest destination amount of an order.
+	MinNotional github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_notional,json=minNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_notional" yaml:"min_notional"`
 }
 
-func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintMarket(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.DestinationFilled.Size()
-		i -= size
-		if _, err := m.DestinationFilled.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMarket(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+func (m *InstrumentConfig) Reset()         { *m = InstrumentConfig{} }
+func (m *InstrumentConfig) String() string { return proto.CompactTextString(m) }
+func (*InstrumentConfig) ProtoMessage()    {}
+func (*InstrumentConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{6}
+}
+func (m *InstrumentConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InstrumentConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InstrumentConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.SourceFilled.Size()
-		i -= size
-		if _, err := m.SourceFilled.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+}
+func (m *InstrumentConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstrumentConfig.Merge(m, src)
+}
+func (m *InstrumentConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *InstrumentConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstrumentConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstrumentConfig proto.InternalMessageInfo
+
+func (m *InstrumentConfig) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.SourceRemaining.Size()
-		i -= size
-		if _, err := m.SourceRemaining.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *InstrumentConfig) GetDestination() string {
+	if m != nil {
+		return m.Destination
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+// CircuitBreaker limits the prices at which an instrument trades to a band
+// around a reference price, which is the last price of the instrument at the
+// start of each window. The band applies to both directions of the
+// instrument.
+type CircuitBreaker struct {
+	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	// Maximum relative deviation from the reference price, e.g. 0.1 for 10%.
+	MaxDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_deviation,json=maxDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_deviation" yaml:"max_deviation"`
+	// Time after which the reference price is reset to the last price.
+	Window time.Duration `protobuf:"bytes,4,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+	// Number of breaches within a window that halts trading in the instrument,
+	// where zero never halts it.
+	MaxBreaches uint32 `protobuf:"varint,5,opt,name=max_breaches,json=maxBreaches,proto3" json:"max_breaches,omitempty" yaml:"max_breaches"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{7}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMarket(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.ClientOrderID) > 0 {
-		i -= len(m.ClientOrderID)
-		copy(dAtA[i:], m.ClientOrderID)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.ClientOrderID)))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	if m.TimeInForce != 0 {
-		i = encodeVarintMarket(dAtA, i, uint64(m.TimeInForce))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *CircuitBreaker) GetDestination() string {
+	if m != nil {
+		return m.Destination
 	}
-	if m.ID != 0 {
-		i = encodeVarintMarket(dAtA, i, uint64(m.ID))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *CircuitBreaker) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ExecutionPlan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CircuitBreaker) GetMaxBreaches() uint32 {
+	if m != nil {
+		return m.MaxBreaches
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ExecutionPlan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PriceBand is the state of the circuit breaker of an instrument within the
+// current window.
+type PriceBand struct {
+	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	// Unset while the instrument has not traded.
+	ReferencePrice *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reference_price,json=referencePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reference_price,omitempty" yaml:"reference_price"`
+	WindowStart    time.Time                               `protobuf:"bytes,4,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start" yaml:"window_start"`
+	// Number of fills that were prevented within the window, as their price was
+	// outside of the band.
+	Breaches uint32 `protobuf:"varint,5,opt,name=breaches,proto3" json:"breaches,omitempty" yaml:"breaches"`
 }
 
-func (m *ExecutionPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SecondOrder != nil {
-		{
-			size, err := m.SecondOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMarket(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.FirstOrder != nil {
-		{
-			size, err := m.FirstOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMarket(dAtA, i, uint64(size))
+func (m *PriceBand) Reset()         { *m = PriceBand{} }
+func (m *PriceBand) String() string { return proto.CompactTextString(m) }
+func (*PriceBand) ProtoMessage()    {}
+func (*PriceBand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{8}
+}
+func (m *PriceBand) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceBand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceBand.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+}
+func (m *PriceBand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceBand.Merge(m, src)
+}
+func (m *PriceBand) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceBand) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceBand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceBand proto.InternalMessageInfo
+
+func (m *PriceBand) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MarketData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceBand) GetDestination() string {
+	if m != nil {
+		return m.Destination
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MarketData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PriceBand) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
 }
 
-func (m *MarketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Timestamp != nil {
-		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintMarket(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x22
+func (m *PriceBand) GetBreaches() uint32 {
+	if m != nil {
+		return m.Breaches
 	}
-	if m.LastPrice != nil {
-		{
-			size := m.LastPrice.Size()
-			i -= size
-			if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintMarket(dAtA, i, uint64(size))
+	return 0
+}
+
+// TradingHalt suspends trading in both directions of an instrument until it is
+// resumed by the authority.
+type TradingHalt struct {
+	Source      string    `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source"`
+	Destination string    `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	Halted      time.Time `protobuf:"bytes,3,opt,name=halted,proto3,stdtime" json:"halted" yaml:"halted"`
+	// Set if trading was halted by a circuit breaker rather than the authority.
+	Automatic bool `protobuf:"varint,4,opt,name=automatic,proto3" json:"automatic,omitempty" yaml:"automatic"`
+}
+
+func (m *TradingHalt) Reset()         { *m = TradingHalt{} }
+func (m *TradingHalt) String() string { return proto.CompactTextString(m) }
+func (*TradingHalt) ProtoMessage()    {}
+func (*TradingHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{9}
+}
+func (m *TradingHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TradingHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TradingHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *TradingHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradingHalt.Merge(m, src)
+}
+func (m *TradingHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *TradingHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradingHalt.DiscardUnknown(m)
 }
 
-func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_TradingHalt proto.InternalMessageInfo
+
+func (m *TradingHalt) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Instrument) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *TradingHalt) GetDestination() string {
+	if m != nil {
+		return m.Destination
 	}
-	var l int
-	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return ""
+}
+
+func (m *TradingHalt) GetHalted() time.Time {
+	if m != nil {
+		return m.Halted
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return time.Time{}
+}
+
+func (m *TradingHalt) GetAutomatic() bool {
+	if m != nil {
+		return m.Automatic
 	}
-	return n
+	return false
 }
 
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
+// Trade is a single fill of a passive order.
+type Trade struct {
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	// The amount sold by the passive order.
+	Source types.Coin `protobuf:"bytes,2,opt,name=source,proto3" json:"source" yaml:"source"`
+	// The amount bought by the passive order.
+	Destination       types.Coin                             `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	Price             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	PassiveOrderID    uint64                                 `protobuf:"varint,5,opt,name=passive_order_id,json=passiveOrderId,proto3" json:"passive_order_id,omitempty" yaml:"passive_order_id"`
+	AggressiveOrderID uint64                                 `protobuf:"varint,6,opt,name=aggressive_order_id,json=aggressiveOrderId,proto3" json:"aggressive_order_id,omitempty" yaml:"aggressive_order_id"`
+	Height            int64                                  `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Timestamp         time.Time                              `protobuf:"bytes,8,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+}
+
+func (m *Trade) Reset()         { *m = Trade{} }
+func (m *Trade) String() string { return proto.CompactTextString(m) }
+func (*Trade) ProtoMessage()    {}
+func (*Trade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{10}
+}
+func (m *Trade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.ID != 0 {
-		n += 1 + sovMarket(uint64(m.ID))
+}
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
+}
+func (m *Trade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trade proto.InternalMessageInfo
+
+func (m *Trade) GetID() uint64 {
+	if m != nil {
+		return m.ID
 	}
-	if m.TimeInForce != 0 {
-		n += 1 + sovMarket(uint64(m.TimeInForce))
+	return 0
+}
+
+func (m *Trade) GetSource() types.Coin {
+	if m != nil {
+		return m.Source
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return types.Coin{}
+}
+
+func (m *Trade) GetDestination() types.Coin {
+	if m != nil {
+		return m.Destination
 	}
-	l = len(m.ClientOrderID)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return types.Coin{}
+}
+
+func (m *Trade) GetPassiveOrderID() uint64 {
+	if m != nil {
+		return m.PassiveOrderID
 	}
-	l = m.Source.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = m.SourceRemaining.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = m.SourceFilled.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = m.Destination.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = m.DestinationFilled.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovMarket(uint64(l))
-	return n
+	return 0
 }
 
-func (m *ExecutionPlan) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Trade) GetAggressiveOrderID() uint64 {
+	if m != nil {
+		return m.AggressiveOrderID
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	if m.FirstOrder != nil {
-		l = m.FirstOrder.Size()
-		n += 1 + l + sovMarket(uint64(l))
+	return 0
+}
+
+func (m *Trade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	if m.SecondOrder != nil {
-		l = m.SecondOrder.Size()
-		n += 1 + l + sovMarket(uint64(l))
+	return 0
+}
+
+func (m *Trade) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
 	}
-	return n
+	return time.Time{}
 }
 
-func (m *MarketData) Size() (n int) {
-	if m == nil {
-		return 0
+// Candle aggregates the trades of an instrument within an interval. Prices are
+// stated in destination per source and the volume in the source denomination.
+type Candle struct {
+	Start  time.Time                              `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start" yaml:"start"`
+	Open   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=open,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open" yaml:"open"`
+	High   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=high,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"high" yaml:"high"`
+	Low    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=low,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"low" yaml:"low"`
+	Close  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=close,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close" yaml:"close"`
+	Volume github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"volume" yaml:"volume"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{11}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
+// OrderRecord is a completed order in the order history of its owner.
+type OrderRecord struct {
+	ID                uint64                                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	OrderID           uint64                                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty" yaml:"order_id"`
+	Owner             string                                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	ClientOrderID     string                                 `protobuf:"bytes,4,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty" yaml:"client_order_id"`
+	Status            OrderStatus                            `protobuf:"varint,5,opt,name=status,proto3,enum=em.market.v1.OrderStatus" json:"status,omitempty" yaml:"status"`
+	Source            types.Coin                             `protobuf:"bytes,6,opt,name=source,proto3" json:"source" yaml:"source"`
+	Destination       types.Coin                             `protobuf:"bytes,7,opt,name=destination,proto3" json:"destination" yaml:"destination"`
+	SourceFilled      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=source_filled,json=sourceFilled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"source_filled" yaml:"source_filled"`
+	DestinationFilled github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=destination_filled,json=destinationFilled,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"destination_filled" yaml:"destination_filled"`
+	// Destination filled per source filled, or zero if nothing was filled.
+	AveragePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=average_price,json=averagePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_price" yaml:"average_price"`
+	Created      time.Time                              `protobuf:"bytes,11,opt,name=created,proto3,stdtime" json:"created" yaml:"created"`
+	// Height and time of the block in which the order was completed.
+	Height int64     `protobuf:"varint,12,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	Closed time.Time `protobuf:"bytes,13,opt,name=closed,proto3,stdtime" json:"closed" yaml:"closed"`
+}
+
+func (m *OrderRecord) Reset()         { *m = OrderRecord{} }
+func (m *OrderRecord) String() string { return proto.CompactTextString(m) }
+func (*OrderRecord) ProtoMessage()    {}
+func (*OrderRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_888ec7fc0f7580e2, []int{12}
+}
+func (m *OrderRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderRecord.Merge(m, src)
+}
+func (m *OrderRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderRecord proto.InternalMessageInfo
+
+func (m *OrderRecord) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *OrderRecord) GetOrderID() uint64 {
+	if m != nil {
+		return m.OrderID
+	}
+	return 0
+}
+
+func (m *OrderRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *OrderRecord) GetClientOrderID() string {
+	if m != nil {
+		return m.ClientOrderID
+	}
+	return ""
+}
+
+func (m *OrderRecord) GetStatus() OrderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return OrderStatus_Unspecified
+}
+
+func (m *OrderRecord) GetSource() types.Coin {
+	if m != nil {
+		return m.Source
+	}
+	return types.Coin{}
+}
+
+func (m *OrderRecord) GetDestination() types.Coin {
+	if m != nil {
+		return m.Destination
+	}
+	return types.Coin{}
+}
+
+func (m *OrderRecord) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *OrderRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *OrderRecord) GetClosed() time.Time {
+	if m != nil {
+		return m.Closed
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("em.market.v1.TimeInForce", TimeInForce_name, TimeInForce_value)
+	proto.RegisterEnum("em.market.v1.PostOnlyMode", PostOnlyMode_name, PostOnlyMode_value)
+	proto.RegisterEnum("em.market.v1.SelfTradePrevention", SelfTradePrevention_name, SelfTradePrevention_value)
+	proto.RegisterEnum("em.market.v1.ConditionType", ConditionType_name, ConditionType_value)
+	proto.RegisterEnum("em.market.v1.OrderStatus", OrderStatus_name, OrderStatus_value)
+	proto.RegisterType((*Instrument)(nil), "em.market.v1.Instrument")
+	proto.RegisterType((*Order)(nil), "em.market.v1.Order")
+	proto.RegisterType((*ConditionalOrder)(nil), "em.market.v1.ConditionalOrder")
+	proto.RegisterType((*ExecutionPlan)(nil), "em.market.v1.ExecutionPlan")
+	proto.RegisterType((*MarketData)(nil), "em.market.v1.MarketData")
+	proto.RegisterType((*Params)(nil), "em.market.v1.Params")
+	proto.RegisterType((*InstrumentConfig)(nil), "em.market.v1.InstrumentConfig")
+	proto.RegisterType((*CircuitBreaker)(nil), "em.market.v1.CircuitBreaker")
+	proto.RegisterType((*PriceBand)(nil), "em.market.v1.PriceBand")
+	proto.RegisterType((*TradingHalt)(nil), "em.market.v1.TradingHalt")
+	proto.RegisterType((*Trade)(nil), "em.market.v1.Trade")
+	proto.RegisterType((*Candle)(nil), "em.market.v1.Candle")
+	proto.RegisterType((*OrderRecord)(nil), "em.market.v1.OrderRecord")
+}
+
+func init() { proto.RegisterFile("em/market/v1/market.proto", fileDescriptor_888ec7fc0f7580e2) }
+
+var fileDescriptor_888ec7fc0f7580e2 = []byte{
+	// 2520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4b, 0x6f, 0x23, 0xc7,
+	0xf1, 0x17, 0x49, 0x89, 0x12, 0x9b, 0xa4, 0x44, 0xb5, 0xa4, 0x35, 0x45, 0xef, 0x5f, 0xe4, 0xce,
+	0xe2, 0xef, 0xc7, 0x1a, 0x4b, 0x66, 0xd7, 0x4e, 0x10, 0x1b, 0x8e, 0x0d, 0x0d, 0x39, 0xda, 0xa5,
+	0x97, 0x12, 0xe9, 0x21, 0xed, 0x8d, 0x83, 0x20, 0x83, 0xd1, 0x4c, 0x8b, 0xec, 0x68, 0x1e, 0xc4,
+	0x4c, 0xeb, 0xe5, 0x4f, 0x10, 0xe8, 0x12, 0xe7, 0xe6, 0x8b, 0x00, 0x23, 0xc8, 0x21, 0xdf, 0x20,
+	0x5f, 0x20, 0x87, 0x3d, 0x3a, 0xc8, 0x25, 0xc8, 0x81, 0x09, 0xb4, 0x40, 0x0e, 0x41, 0x0e, 0x81,
+	0x00, 0x1f, 0x92, 0x53, 0xd0, 0x8f, 0x21, 0x67, 0x28, 0xc9, 0x5a, 0x5a, 0xbb, 0xeb, 0x13, 0xa7,
+	0xab, 0xab, 0x7e, 0xd5, 0x5d, 0x55, 0x5d, 0x5d, 0x5d, 0x04, 0xab, 0xc8, 0xae, 0xd8, 0xba, 0xb7,
+	0x8b, 0x48, 0x65, 0xff, 0x9e, 0xf8, 0x2a, 0xf7, 0x3d, 0x97, 0xb8, 0x30, 0x83, 0xec, 0xb2, 0x20,
+	0xec, 0xdf, 0x2b, 0x2c, 0x77, 0xdd, 0xae, 0xcb, 0x26, 0x2a, 0xf4, 0x8b, 0xf3, 0x14, 0x8a, 0x5d,
+	0xd7, 0xed, 0x5a, 0xa8, 0xc2, 0x46, 0xdb, 0x7b, 0x3b, 0x15, 0x82, 0x6d, 0xe4, 0x13, 0xdd, 0xee,
+	0x0b, 0x86, 0xb5, 0x71, 0x06, 0x73, 0xcf, 0xd3, 0x09, 0x76, 0x9d, 0x60, 0xde, 0x70, 0x7d, 0xdb,
+	0xf5, 0x2b, 0xdb, 0xba, 0x8f, 0x2a, 0xfb, 0xf7, 0xb6, 0x11, 0xd1, 0xef, 0x55, 0x0c, 0x17, 0x8b,
+	0x79, 0x69, 0x03, 0x80, 0xba, 0xe3, 0x13, 0x6f, 0xcf, 0x46, 0x0e, 0x81, 0x37, 0x40, 0xd2, 0x77,
+	0xf7, 0x3c, 0x03, 0xe5, 0x63, 0xa5, 0xd8, 0x1b, 0x29, 0x55, 0x8c, 0x60, 0x09, 0xa4, 0x4d, 0xe4,
+	0x13, 0xec, 0x30, 0xe8, 0x7c, 0x9c, 0x4d, 0x86, 0x49, 0xd2, 0x3f, 0xd3, 0x60, 0xa6, 0xe9, 0x99,
+	0xc8, 0x83, 0xef, 0x80, 0x39, 0x97, 0x7e, 0x68, 0xd8, 0x64, 0x28, 0xd3, 0xf2, 0xea, 0xe9, 0xa0,
+	0x18, 0xaf, 0xd7, 0xce, 0x06, 0xc5, 0x85, 0x23, 0xdd, 0xb6, 0xde, 0x93, 0x82, 0x79, 0x49, 0x9d,
+	0x65, 0x9f, 0x75, 0x13, 0x3e, 0x06, 0x59, 0xba, 0x35, 0x0d, 0x3b, 0xda, 0x8e, 0x4b, 0x17, 0x40,
+	0x75, 0xcc, 0xdf, 0x5f, 0x2d, 0x87, 0x8d, 0x54, 0xee, 0x60, 0x1b, 0xd5, 0x9d, 0x0d, 0xca, 0x20,
+	0xe7, 0xcf, 0x06, 0xc5, 0x65, 0x8e, 0x17, 0x91, 0x94, 0xd4, 0x34, 0x19, 0xb1, 0xc1, 0xd7, 0xc0,
+	0x8c, 0x7b, 0xe0, 0x20, 0x2f, 0x9f, 0xa0, 0x8b, 0x96, 0x73, 0x67, 0x83, 0x62, 0x46, 0xac, 0x82,
+	0x92, 0x25, 0x95, 0x4f, 0xc3, 0x36, 0x58, 0x30, 0x2c, 0x8c, 0x1c, 0xa2, 0x0d, 0x57, 0x3f, 0xcd,
+	0x24, 0xde, 0x3a, 0x1d, 0x14, 0xb3, 0x55, 0x36, 0xc5, 0x36, 0xc8, 0x36, 0x72, 0x83, 0x43, 0x8c,
+	0x49, 0x48, 0x6a, 0xd6, 0x08, 0x31, 0x9a, 0xf0, 0xe1, 0xd0, 0x9e, 0x33, 0xa5, 0xd8, 0x1b, 0xe9,
+	0xfb, 0xab, 0x65, 0xee, 0x8e, 0x32, 0x75, 0x47, 0x59, 0xb8, 0xa3, 0x5c, 0x75, 0xb1, 0x23, 0xaf,
+	0x3c, 0x19, 0x14, 0xa7, 0xce, 0x06, 0xc5, 0x2c, 0x47, 0xe6, 0x62, 0xd2, 0xd0, 0x03, 0x04, 0xe4,
+	0xf8, 0x97, 0xe6, 0x21, 0x5b, 0xc7, 0x0e, 0x76, 0xba, 0xf9, 0x24, 0x5b, 0x5f, 0x9d, 0x0a, 0xfe,
+	0x75, 0x50, 0x7c, 0xad, 0x8b, 0x49, 0x6f, 0x6f, 0xbb, 0x6c, 0xb8, 0x76, 0x45, 0x38, 0x9d, 0xff,
+	0xdc, 0xf5, 0xcd, 0xdd, 0x0a, 0x39, 0xea, 0x23, 0xbf, 0x5c, 0x77, 0xc8, 0xd9, 0xa0, 0xf8, 0x4a,
+	0x58, 0xc5, 0x08, 0x4f, 0x52, 0x17, 0x38, 0x49, 0x0d, 0x28, 0x70, 0x17, 0x64, 0x05, 0xd7, 0x0e,
+	0xb6, 0x2c, 0x64, 0xe6, 0x67, 0x99, 0xca, 0x8d, 0x89, 0x55, 0x2e, 0x47, 0x54, 0x72, 0x30, 0x49,
+	0xcd, 0xf0, 0xf1, 0x06, 0x1b, 0xc2, 0xc7, 0xd1, 0x20, 0x9b, 0xbb, 0xca, 0x62, 0x05, 0x61, 0x31,
+	0xc8, 0xb1, 0xc3, 0xd1, 0x18, 0x89, 0x4d, 0xf8, 0x39, 0x80, 0xa1, 0x61, 0xb0, 0x95, 0x14, 0xdb,
+	0xca, 0xa3, 0x89, 0xb7, 0xb2, 0x7a, 0x4e, 0xdd, 0x70, 0x3f, 0x8b, 0x21, 0xa2, 0xd8, 0x54, 0x0b,
+	0xcc, 0x1a, 0x1e, 0xd2, 0x09, 0x32, 0xf3, 0x80, 0x6d, 0xa8, 0x50, 0xe6, 0x27, 0xb6, 0x1c, 0x9c,
+	0xd8, 0x72, 0x27, 0x38, 0xd2, 0xc3, 0x1d, 0xcd, 0x8b, 0xe8, 0xe2, 0x82, 0xd2, 0x17, 0x7f, 0x2b,
+	0xc6, 0xd4, 0x00, 0x06, 0x1a, 0x60, 0xbe, 0xeb, 0xba, 0xa6, 0x46, 0xb0, 0x65, 0x69, 0x34, 0xd2,
+	0xf3, 0xe9, 0x2b, 0x81, 0x6f, 0x3d, 0x19, 0x14, 0x63, 0x67, 0x83, 0xe2, 0x0a, 0x07, 0x8e, 0xca,
+	0x73, 0xfc, 0x0c, 0x25, 0x76, 0xb0, 0x65, 0x51, 0x29, 0x28, 0x83, 0x85, 0x11, 0xd3, 0xb6, 0xe5,
+	0x1a, 0xbb, 0xf9, 0x4c, 0x29, 0xf6, 0x46, 0x42, 0x2e, 0x8c, 0x82, 0x7f, 0x8c, 0x41, 0x52, 0xb3,
+	0x01, 0x84, 0x4c, 0xc7, 0x70, 0x13, 0xa4, 0xfa, 0xae, 0x4f, 0x34, 0xd7, 0xb1, 0x8e, 0xf2, 0x59,
+	0x76, 0x9c, 0x0b, 0xd1, 0xe3, 0xdc, 0x72, 0x7d, 0xd2, 0x74, 0xac, 0xa3, 0x4d, 0xd7, 0x44, 0xf2,
+	0xf2, 0xd9, 0xa0, 0x98, 0xe3, 0xc8, 0x43, 0x31, 0x49, 0x9d, 0xeb, 0x0b, 0x1e, 0x78, 0x00, 0x56,
+	0x7c, 0x64, 0xed, 0x68, 0xc4, 0xd3, 0x4d, 0xa4, 0xf5, 0x3d, 0xb4, 0x8f, 0x1c, 0x16, 0x28, 0xf3,
+	0x0c, 0xfa, 0x56, 0x14, 0xba, 0x8d, 0xac, 0x9d, 0x0e, 0xe5, 0x6c, 0x0d, 0x19, 0xe5, 0xd2, 0xd9,
+	0xa0, 0x78, 0x53, 0x04, 0xe2, 0x45, 0x48, 0x92, 0xba, 0xe4, 0x9f, 0x17, 0x83, 0x26, 0xc8, 0x98,
+	0xd8, 0xef, 0x5b, 0xfa, 0x91, 0xe6, 0xe3, 0xcf, 0x51, 0x7e, 0x81, 0x05, 0xce, 0xfa, 0x44, 0x41,
+	0xb3, 0x24, 0x82, 0x26, 0x84, 0x43, 0x83, 0x94, 0x0f, 0xdb, 0xf8, 0x73, 0x04, 0x7d, 0xb0, 0x18,
+	0xcc, 0x8e, 0x4e, 0x78, 0x8e, 0x1f, 0xb7, 0x89, 0x54, 0xe5, 0xa3, 0xaa, 0x42, 0xc7, 0x3b, 0x27,
+	0x68, 0xa3, 0xf3, 0x5d, 0x01, 0x73, 0x7d, 0x0f, 0xbb, 0x1e, 0x26, 0x47, 0xf9, 0x45, 0x96, 0xab,
+	0x97, 0x46, 0x59, 0x3a, 0x98, 0xa1, 0x4e, 0x10, 0x9f, 0xef, 0x4d, 0x7f, 0xf9, 0x55, 0x71, 0x4a,
+	0xfa, 0x4d, 0x1c, 0xe4, 0xaa, 0xae, 0x63, 0x62, 0x6a, 0x1f, 0xdd, 0xe2, 0x79, 0xff, 0x43, 0x30,
+	0xc3, 0xf2, 0x20, 0x4b, 0xfa, 0xe9, 0xfb, 0x4b, 0x51, 0x7f, 0x30, 0x1e, 0x79, 0x59, 0x04, 0x78,
+	0x26, 0x74, 0x0f, 0xd0, 0x0c, 0xcc, 0x00, 0x9a, 0x20, 0x65, 0x04, 0xa0, 0x22, 0xfd, 0xbf, 0x1a,
+	0x05, 0x19, 0xea, 0xec, 0x1c, 0xf5, 0x23, 0x01, 0x33, 0x94, 0x93, 0xd4, 0x11, 0x06, 0xcd, 0x5e,
+	0xc4, 0xc3, 0xdd, 0x2e, 0xf2, 0xb4, 0xbe, 0x87, 0x0d, 0x94, 0x4f, 0x4c, 0x9c, 0xbd, 0x6a, 0xc8,
+	0x08, 0x5d, 0x33, 0x61, 0x30, 0x49, 0xcd, 0x88, 0x71, 0x8b, 0x0d, 0x7f, 0x15, 0x03, 0x59, 0xe5,
+	0x10, 0x19, 0x7b, 0x54, 0x75, 0xcb, 0xd2, 0x1d, 0x58, 0x03, 0x33, 0x5c, 0x2d, 0xbb, 0x4b, 0xe5,
+	0xf2, 0x64, 0x6a, 0x55, 0x2e, 0x0c, 0xdf, 0x02, 0x49, 0x66, 0x1e, 0x3f, 0x3f, 0x5d, 0x4a, 0x5c,
+	0x62, 0x57, 0x55, 0xb0, 0x08, 0xf7, 0xfc, 0x29, 0x06, 0xc0, 0x26, 0xe3, 0xa8, 0xe9, 0x44, 0xff,
+	0xee, 0x97, 0x3a, 0xac, 0x03, 0x60, 0xe9, 0x3e, 0x89, 0x58, 0xef, 0xce, 0x04, 0x5b, 0x48, 0x51,
+	0x69, 0x66, 0x1e, 0xf8, 0x01, 0x48, 0x0d, 0x4b, 0x97, 0xfc, 0xf4, 0x95, 0x09, 0x6b, 0x9a, 0xe5,
+	0xa4, 0x91, 0x88, 0xf4, 0xc7, 0x59, 0x90, 0x6c, 0xe9, 0x9e, 0x6e, 0xfb, 0xf0, 0x63, 0xb0, 0xcc,
+	0x4f, 0x6e, 0x0f, 0xfb, 0xc4, 0xf5, 0x8e, 0x34, 0x0b, 0x39, 0x5d, 0xd2, 0x63, 0xbb, 0xcb, 0xca,
+	0xc5, 0xb3, 0x41, 0xf1, 0xd5, 0xc0, 0x5f, 0xe7, 0xb9, 0x24, 0x15, 0x32, 0xf2, 0x43, 0x4e, 0x6d,
+	0x30, 0x22, 0xc4, 0x20, 0x67, 0xe8, 0x8e, 0x69, 0xd1, 0x2a, 0x82, 0x20, 0x6f, 0x5f, 0xb7, 0xfc,
+	0x7c, 0x9c, 0x99, 0x7b, 0xf5, 0xdc, 0x22, 0x6b, 0xa2, 0xc0, 0x92, 0x6f, 0x8b, 0x60, 0x16, 0xd7,
+	0xe9, 0x38, 0x80, 0xf4, 0x25, 0xdd, 0xc2, 0x02, 0x27, 0xd7, 0x03, 0x2a, 0xec, 0x80, 0x15, 0xc1,
+	0x39, 0xb6, 0xfc, 0x04, 0x5b, 0x7e, 0x28, 0x47, 0x5d, 0xc8, 0x26, 0xa9, 0x4b, 0x9c, 0x1e, 0xdd,
+	0x80, 0x06, 0x52, 0xb6, 0xbe, 0x8b, 0x3c, 0x6d, 0x07, 0x21, 0x51, 0xb7, 0xc8, 0x13, 0x87, 0xb9,
+	0x38, 0x4c, 0x43, 0x20, 0x49, 0x9d, 0x63, 0xdf, 0x1b, 0x08, 0x51, 0x05, 0x64, 0xa8, 0x60, 0xe6,
+	0x7a, 0x0a, 0x48, 0x48, 0x01, 0x09, 0x14, 0x7c, 0x0c, 0x96, 0x79, 0x19, 0x35, 0x66, 0x96, 0xe4,
+	0xb8, 0x57, 0x2f, 0xe2, 0x92, 0x54, 0xc8, 0xc8, 0x51, 0xa3, 0xf4, 0x40, 0xce, 0xc0, 0x9e, 0xb1,
+	0x87, 0x89, 0xb6, 0xed, 0x21, 0xaa, 0xc8, 0xcf, 0xcf, 0x32, 0xaf, 0xde, 0x1c, 0xcb, 0x2b, 0x9c,
+	0x4b, 0xe6, 0x4c, 0x72, 0x71, 0xcc, 0xb1, 0x63, 0x18, 0x92, 0xba, 0x60, 0x44, 0x04, 0x7c, 0x66,
+	0x1d, 0x6c, 0xec, 0xf2, 0xfb, 0x61, 0xee, 0x9a, 0xd6, 0x09, 0x80, 0xa8, 0x75, 0xb0, 0xb1, 0xcb,
+	0x6e, 0x87, 0x9f, 0x83, 0x39, 0xcb, 0x25, 0x1c, 0x9f, 0x17, 0x2e, 0xeb, 0x13, 0x17, 0x2e, 0x22,
+	0xad, 0x07, 0x38, 0x92, 0x3a, 0x6b, 0xb9, 0x84, 0xa1, 0xf7, 0x40, 0xc6, 0xc6, 0x8e, 0xe6, 0xb8,
+	0x3c, 0x9f, 0xb3, 0x4a, 0x25, 0x25, 0x2b, 0x13, 0x6b, 0x10, 0xb7, 0x5c, 0x18, 0x4b, 0x52, 0xd3,
+	0x36, 0x76, 0xb6, 0x82, 0xd1, 0x57, 0x09, 0x90, 0x1b, 0xbd, 0x37, 0xaa, 0xae, 0xb3, 0x83, 0xbb,
+	0xf0, 0xcd, 0x68, 0x82, 0x92, 0x17, 0x2f, 0x2f, 0x83, 0x7f, 0x7c, 0x41, 0xce, 0x92, 0x6f, 0x3c,
+	0x4b, 0x11, 0x18, 0x71, 0x51, 0xe2, 0x05, 0xbb, 0x68, 0xfa, 0x85, 0xbb, 0x68, 0xe6, 0x85, 0xb9,
+	0xe8, 0x5f, 0x71, 0x30, 0x1f, 0x3d, 0x10, 0x2f, 0xc7, 0x41, 0xbb, 0x20, 0x6b, 0xeb, 0x87, 0x9a,
+	0x89, 0xf6, 0x31, 0x97, 0xbd, 0xe6, 0x6d, 0x1d, 0x01, 0x93, 0xd4, 0x8c, 0xad, 0x1f, 0xd6, 0x82,
+	0x21, 0x6c, 0x80, 0xe4, 0x01, 0x76, 0x4c, 0xf7, 0x40, 0xdc, 0x45, 0xdf, 0x92, 0xe6, 0x57, 0xa3,
+	0x0f, 0x33, 0x2e, 0xc6, 0x93, 0xbb, 0xc0, 0x80, 0xef, 0x01, 0x8a, 0xce, 0x12, 0x84, 0xd1, 0x43,
+	0x3e, 0x73, 0x4e, 0x56, 0x7e, 0x25, 0x64, 0xee, 0xd0, 0x2c, 0x35, 0xb7, 0x7e, 0x28, 0x07, 0xa3,
+	0x7f, 0xc7, 0x41, 0x8a, 0x5d, 0x91, 0xb2, 0xee, 0x98, 0x2f, 0xc7, 0xd2, 0x36, 0x58, 0xf0, 0xd0,
+	0x0e, 0xf2, 0x90, 0x63, 0xa0, 0xc8, 0xdd, 0x5e, 0x9b, 0xc8, 0xce, 0xe2, 0x19, 0x30, 0x06, 0x25,
+	0xa9, 0xf3, 0x43, 0x0a, 0xbf, 0xfa, 0x7f, 0x01, 0x32, 0xdc, 0x4e, 0x9a, 0x4f, 0x74, 0x8f, 0x3c,
+	0xc3, 0xed, 0x1f, 0x24, 0xe0, 0xa5, 0xb0, 0xc9, 0xb9, 0x34, 0x7f, 0xac, 0xa4, 0x39, 0xa9, 0x4d,
+	0x29, 0xb4, 0x88, 0x1d, 0xb3, 0x7c, 0xa8, 0x88, 0x1d, 0x59, 0x7d, 0xc8, 0x24, 0x7d, 0x13, 0x03,
+	0x69, 0x5a, 0xe4, 0x63, 0xa7, 0xfb, 0x50, 0xb7, 0xc8, 0xcb, 0x31, 0xfa, 0x26, 0x48, 0xf6, 0x74,
+	0x8b, 0xbe, 0x03, 0x13, 0x57, 0xee, 0x7f, 0x2c, 0xe4, 0xb8, 0x1c, 0xdf, 0xb9, 0x00, 0x81, 0xf7,
+	0x41, 0x4a, 0xdf, 0x23, 0xae, 0xad, 0x13, 0x6c, 0x30, 0x8b, 0xce, 0x85, 0xeb, 0xe1, 0xe1, 0x94,
+	0xa4, 0x8e, 0xd8, 0xa4, 0x7f, 0x4c, 0x83, 0x19, 0xba, 0x6f, 0x04, 0x6f, 0x83, 0xf8, 0xb0, 0x3b,
+	0xb3, 0x34, 0xec, 0xce, 0xa4, 0xb8, 0x30, 0xed, 0x63, 0xc4, 0x71, 0xb8, 0x79, 0x11, 0xbf, 0x66,
+	0xf3, 0x62, 0xec, 0x65, 0x9f, 0x78, 0x6e, 0x2f, 0xfb, 0x4e, 0x50, 0x62, 0xf3, 0x84, 0xfb, 0xc1,
+	0xc4, 0xb9, 0x22, 0x33, 0x7c, 0xea, 0xd0, 0xf5, 0x8a, 0x92, 0xfb, 0x31, 0xc8, 0xf5, 0x75, 0xdf,
+	0xc7, 0xfb, 0x68, 0xd4, 0x0b, 0x9a, 0x61, 0xb6, 0xba, 0x7b, 0x3a, 0x28, 0xce, 0xb7, 0xf8, 0xdc,
+	0xa8, 0x19, 0x24, 0xea, 0x84, 0x71, 0x19, 0x49, 0x9d, 0xef, 0x87, 0x59, 0xe9, 0xd3, 0x7d, 0x49,
+	0xef, 0x76, 0x3d, 0x34, 0x86, 0x9d, 0x64, 0xd8, 0x6f, 0x9f, 0x0e, 0x8a, 0x8b, 0xeb, 0xc3, 0xe9,
+	0x11, 0x7c, 0x41, 0xf8, 0xf4, 0xbc, 0xa4, 0xa4, 0x2e, 0xea, 0x63, 0x02, 0x2c, 0x85, 0xf4, 0x10,
+	0xee, 0xf6, 0x08, 0x6b, 0xd6, 0x24, 0xc2, 0xd1, 0xcc, 0xe9, 0x92, 0x2a, 0x18, 0xe0, 0xa7, 0xe1,
+	0xa2, 0x7c, 0xee, 0xca, 0xb0, 0xbc, 0x29, 0xdc, 0x92, 0x1b, 0x75, 0xdd, 0xd8, 0x84, 0x34, 0x5e,
+	0xac, 0xff, 0x27, 0x01, 0x92, 0x55, 0x56, 0xa5, 0xc2, 0x8f, 0xc0, 0x0c, 0x3f, 0xf5, 0xb1, 0x2b,
+	0xe1, 0xf3, 0xd1, 0xc7, 0x61, 0xe8, 0xb8, 0x73, 0x08, 0xf8, 0x31, 0x98, 0x76, 0xfb, 0x28, 0x38,
+	0x75, 0x3f, 0x99, 0xd8, 0xd9, 0x69, 0x0e, 0x4c, 0x31, 0x24, 0x95, 0x41, 0x51, 0xc8, 0x1e, 0xee,
+	0xf6, 0xf2, 0x89, 0xeb, 0x41, 0x52, 0x0c, 0x49, 0x65, 0x50, 0x70, 0x0b, 0x24, 0x2c, 0x71, 0xaf,
+	0xa4, 0xe4, 0xf7, 0x27, 0x46, 0x04, 0x41, 0x09, 0x70, 0x20, 0xa9, 0x14, 0x88, 0xc6, 0xb8, 0x61,
+	0xb9, 0x7e, 0x50, 0x75, 0x7f, 0xe7, 0x18, 0x67, 0x20, 0x92, 0xca, 0xc1, 0xe0, 0x63, 0x90, 0xdc,
+	0x77, 0xad, 0x3d, 0x1b, 0x89, 0x2e, 0xe2, 0x87, 0x13, 0x57, 0x12, 0x22, 0xa6, 0x38, 0x8a, 0xa4,
+	0x0a, 0x38, 0xe9, 0x9b, 0x59, 0x90, 0xe6, 0x8f, 0x52, 0x64, 0xb8, 0x9e, 0xf9, 0x6c, 0xa9, 0xe6,
+	0xdd, 0x50, 0xcf, 0x38, 0xce, 0x58, 0xd7, 0x4e, 0x07, 0xc5, 0xd9, 0xd1, 0x19, 0xb8, 0xbc, 0x71,
+	0xfc, 0xbd, 0xf6, 0x77, 0x6b, 0x20, 0xe9, 0x13, 0x9d, 0xec, 0xf1, 0x8b, 0xe7, 0x5c, 0xbb, 0x9a,
+	0xb1, 0xb5, 0x19, 0x43, 0xe4, 0x52, 0x61, 0x14, 0x9a, 0x1e, 0xd9, 0x47, 0x28, 0xd1, 0x26, 0x9f,
+	0x6f, 0xa2, 0x9d, 0x7d, 0x6e, 0x89, 0xf6, 0x5c, 0x23, 0x78, 0xee, 0x05, 0x36, 0x82, 0xbf, 0xcf,
+	0x7e, 0xed, 0x2e, 0xc8, 0xea, 0xfb, 0xc8, 0xd3, 0xbb, 0x41, 0x65, 0x04, 0xae, 0x57, 0x85, 0x46,
+	0xc0, 0x24, 0x35, 0x23, 0xc6, 0xbc, 0x32, 0x0a, 0x35, 0x87, 0xd3, 0xcf, 0xa7, 0x39, 0x3c, 0x4a,
+	0xfe, 0x99, 0xab, 0x92, 0xff, 0x26, 0x48, 0xb2, 0x54, 0x60, 0xe6, 0xb3, 0x57, 0xea, 0x1e, 0x2b,
+	0x48, 0xb8, 0x9c, 0x28, 0x48, 0xf8, 0xe0, 0xce, 0x9f, 0xe3, 0x20, 0x1d, 0xfa, 0x7b, 0x06, 0x96,
+	0xc1, 0x6a, 0xa7, 0xbe, 0xa9, 0x68, 0xf5, 0x2d, 0x6d, 0xa3, 0xa9, 0x56, 0x15, 0xed, 0x93, 0xad,
+	0x76, 0x4b, 0xa9, 0xd6, 0x37, 0xea, 0x4a, 0x2d, 0x37, 0x55, 0x58, 0x38, 0x3e, 0x29, 0xa5, 0x3f,
+	0x71, 0xfc, 0x3e, 0x32, 0xf0, 0x0e, 0x46, 0x26, 0xfc, 0x11, 0x58, 0x8b, 0xf2, 0x3f, 0x68, 0x36,
+	0x6b, 0x5a, 0xa7, 0xde, 0x68, 0x68, 0xd5, 0xf5, 0xad, 0xaa, 0xd2, 0xc8, 0xc5, 0x0a, 0xf0, 0xf8,
+	0xa4, 0x34, 0xff, 0x40, 0x34, 0x99, 0xab, 0xba, 0x63, 0x20, 0x0b, 0xbe, 0x0f, 0x6e, 0x45, 0xe5,
+	0xea, 0x9b, 0x9b, 0x4a, 0xad, 0xbe, 0xde, 0x51, 0xb4, 0xa6, 0x1a, 0x88, 0xc6, 0x0b, 0x2b, 0xc7,
+	0x27, 0xa5, 0xc5, 0xba, 0x6d, 0x23, 0x13, 0xeb, 0x04, 0x35, 0x3d, 0x21, 0x5d, 0x06, 0x85, 0xa8,
+	0xf4, 0x06, 0x55, 0xd8, 0x54, 0xb5, 0x47, 0xf5, 0x46, 0x23, 0x97, 0x28, 0xcc, 0x1f, 0x9f, 0x94,
+	0x00, 0x0d, 0x8d, 0xa6, 0xf7, 0x08, 0x5b, 0x16, 0xbc, 0x0f, 0x6e, 0x5e, 0xb6, 0x4a, 0x4a, 0xcf,
+	0x4d, 0x17, 0x72, 0xc7, 0x27, 0xa5, 0xcc, 0x83, 0x70, 0x2f, 0xfd, 0x1d, 0xf0, 0x7f, 0x97, 0xc9,
+	0xc8, 0x8d, 0x66, 0xf5, 0x51, 0x6e, 0xa6, 0xb0, 0x78, 0x7c, 0x52, 0xca, 0x3e, 0x08, 0x77, 0xcf,
+	0x0b, 0xd3, 0xbf, 0xff, 0xdd, 0x5a, 0xec, 0xce, 0xaf, 0x63, 0x20, 0x13, 0xee, 0x92, 0xc3, 0x37,
+	0xc1, 0x2b, 0xad, 0x66, 0xbb, 0xa3, 0x35, 0xb7, 0x1a, 0x9f, 0x69, 0x9b, 0xcd, 0x9a, 0xa2, 0xd5,
+	0xea, 0xed, 0x75, 0xb9, 0xc1, 0x8c, 0x9a, 0x39, 0x3e, 0x29, 0xcd, 0xd5, 0xb0, 0xaf, 0x6f, 0xd3,
+	0x50, 0xfe, 0x7f, 0xb0, 0x32, 0xc6, 0xaa, 0x2a, 0x1f, 0x29, 0xd5, 0x4e, 0x2e, 0x56, 0x00, 0xc7,
+	0x27, 0xa5, 0xa4, 0x8a, 0x7e, 0x89, 0x0c, 0x02, 0x5f, 0x07, 0x37, 0xce, 0xb1, 0xb5, 0xd4, 0x7a,
+	0x55, 0xc9, 0xc5, 0x0b, 0xe9, 0xe3, 0x93, 0xd2, 0xac, 0x8a, 0x58, 0xf0, 0x8a, 0x15, 0xfd, 0x37,
+	0x06, 0x96, 0x2e, 0x68, 0xae, 0xc3, 0x1f, 0x80, 0xb5, 0xb6, 0xd2, 0xd8, 0xd0, 0x3a, 0xea, 0x7a,
+	0x4d, 0xd1, 0x5a, 0xaa, 0xf2, 0xa9, 0xb2, 0xd5, 0xa9, 0x37, 0xb7, 0x2e, 0x5f, 0xdf, 0xbb, 0xe0,
+	0xf6, 0xc5, 0x12, 0xdc, 0x69, 0xda, 0x96, 0xf2, 0x58, 0x69, 0xd3, 0xd5, 0x32, 0x93, 0x72, 0x87,
+	0x6d, 0xa1, 0x03, 0xe4, 0x93, 0x2b, 0x45, 0x9b, 0x8d, 0x1a, 0x15, 0x8d, 0x87, 0x45, 0x9b, 0x16,
+	0x3d, 0xed, 0xf0, 0x87, 0xe0, 0xd6, 0xb7, 0x8a, 0xca, 0xcd, 0xce, 0xc3, 0xc0, 0xf1, 0x5c, 0x50,
+	0x76, 0x49, 0x4f, 0x6c, 0xfe, 0xb7, 0x31, 0x90, 0x8d, 0x34, 0xa1, 0x61, 0x05, 0x14, 0xaa, 0xcd,
+	0xad, 0x5a, 0x9d, 0x41, 0x74, 0x3e, 0x6b, 0x5d, 0x19, 0xe7, 0x77, 0x40, 0x7e, 0x4c, 0xa0, 0xdd,
+	0x69, 0xb6, 0xb4, 0x46, 0xb3, 0xdd, 0xce, 0xc5, 0xb8, 0x85, 0xda, 0xc4, 0xed, 0x37, 0x5c, 0xdf,
+	0xa7, 0xd1, 0x39, 0xc6, 0xdb, 0x59, 0x7f, 0x44, 0x17, 0xdd, 0xdc, 0xa8, 0xd3, 0xdd, 0xb1, 0x45,
+	0x76, 0xf4, 0x5d, 0xd4, 0xf2, 0xdc, 0x1d, 0x4c, 0xc4, 0x22, 0xff, 0x10, 0x03, 0xe9, 0xd0, 0xcd,
+	0x03, 0xef, 0x82, 0x7c, 0x53, 0xad, 0x29, 0xaa, 0xd6, 0xee, 0xac, 0x77, 0x3e, 0x69, 0x5f, 0xb5,
+	0xc0, 0xdb, 0x60, 0x29, 0xc2, 0x4e, 0x4f, 0x84, 0x52, 0x0b, 0x82, 0x46, 0xa4, 0xc9, 0xd7, 0xc1,
+	0x4a, 0x84, 0x89, 0x1b, 0x4f, 0xa9, 0xe5, 0xe2, 0x7c, 0x0b, 0xdc, 0x72, 0x2c, 0x08, 0x97, 0x23,
+	0x8c, 0xca, 0x4f, 0x5b, 0x75, 0x55, 0xa9, 0xe5, 0x12, 0x3c, 0xb6, 0x94, 0xc3, 0x3e, 0xf6, 0x90,
+	0xc9, 0x57, 0x2e, 0x2b, 0x4f, 0x4e, 0xd7, 0x62, 0x5f, 0x9f, 0xae, 0xc5, 0xfe, 0x7e, 0xba, 0x16,
+	0xfb, 0xe2, 0xe9, 0xda, 0xd4, 0xd7, 0x4f, 0xd7, 0xa6, 0xfe, 0xf2, 0x74, 0x6d, 0xea, 0x67, 0x6f,
+	0x85, 0xf2, 0x2e, 0xba, 0x6b, 0xbb, 0x0e, 0x3a, 0xaa, 0x20, 0xfb, 0xae, 0x85, 0xcc, 0x2e, 0xf2,
+	0x2a, 0x87, 0xc1, 0x5f, 0xec, 0x2c, 0x01, 0x6f, 0x27, 0x59, 0x06, 0x7b, 0xfb, 0x7f, 0x03, 0x00,
+	0x58, 0x07, 0xfa, 0xaa, 0x7c, 0x1f, 0x00, 0x00,
+}
+
+func (m *Instrument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Instrument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Instrument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.LastPrice != nil {
-		l = m.LastPrice.Size()
-		n += 1 + l + sovMarket(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Timestamp != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp)
-		n += 1 + l + sovMarket(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.DisplayRemaining != nil {
+		{
+			size := m.DisplayRemaining.Size()
+			i -= size
+			if _, err := m.DisplayRemaining.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.DisplaySize != nil {
+		{
+			size := m.DisplaySize.Size()
+			i -= size
+			if _, err := m.DisplaySize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.PostOnly != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.PostOnly))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.GoodTillBlock != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.GoodTillBlock))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.GoodTillTime != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.GoodTillTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.GoodTillTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintMarket(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMarket(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.DestinationFilled.Size()
+		i -= size
+		if _, err := m.DestinationFilled.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SourceFilled.Size()
+		i -= size
+		if _, err := m.SourceFilled.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SourceRemaining.Size()
+		i -= size
+		if _, err := m.SourceRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.ClientOrderID) > 0 {
+		i -= len(m.ClientOrderID)
+		copy(dAtA[i:], m.ClientOrderID)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.ClientOrderID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConditionalOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConditionalOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConditionalOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Condition != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Condition))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != nil {
+		n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintMarket(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LastPrice != nil {
+		{
+			size := m.LastPrice.Size()
+			i -= size
+			if _, err := m.LastPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinNotional.Size()
+		i -= size
+		if _, err := m.MinNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.LotSize.Size()
+		i -= size
+		if _, err := m.LotSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.OrderHistoryLength != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.OrderHistoryLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MakerFee.Size()
+		i -= size
+		if _, err := m.MakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CandleHistoryLength != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.CandleHistoryLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.CandleIntervals) > 0 {
+		for iNdEx := len(m.CandleIntervals) - 1; iNdEx >= 0; iNdEx-- {
+			n, err := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.CandleIntervals[iNdEx], dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.CandleIntervals[iNdEx]):])
+			if err != nil {
+				return 0, err
+			}
+			i -= n
+			i = encodeVarintMarket(dAtA, i, uint64(n))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TradeHistoryLength != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.TradeHistoryLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InstrumentConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InstrumentConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InstrumentConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinNotional.Size()
+		i -= size
+		if _, err := m.MinNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LotSize.Size()
+		i -= size
+		if _, err := m.LotSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TickSize.Size()
+		i -= size
+		if _, err := m.TickSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBreaches != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.MaxBreaches))
+		i--
+		dAtA[i] = 0x28
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintMarket(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceBand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceBand) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceBand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Breaches != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Breaches))
+		i--
+		dAtA[i] = 0x28
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintMarket(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if m.ReferencePrice != nil {
+		{
+			size := m.ReferencePrice.Size()
+			i -= size
+			if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TradingHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TradingHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TradingHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Automatic {
+		i--
+		if m.Automatic {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Halted, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Halted):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintMarket(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Trade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintMarket(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x42
+	if m.Height != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AggressiveOrderID != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.AggressiveOrderID))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PassiveOrderID != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.PassiveOrderID))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ID != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Start):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintMarket(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Closed, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Closed):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintMarket(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x6a
+	if m.Height != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x60
+	}
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintMarket(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.AveragePrice.Size()
+		i -= size
+		if _, err := m.AveragePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.DestinationFilled.Size()
+		i -= size
+		if _, err := m.DestinationFilled.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.SourceFilled.Size()
+		i -= size
+		if _, err := m.SourceFilled.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Destination.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Source.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Status != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ClientOrderID) > 0 {
+		i -= len(m.ClientOrderID)
+		copy(dAtA[i:], m.ClientOrderID)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.ClientOrderID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OrderID != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.OrderID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ID != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Instrument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMarket(uint64(m.ID))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovMarket(uint64(m.TimeInForce))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.ClientOrderID)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.SourceRemaining.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.SourceFilled.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.DestinationFilled.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovMarket(uint64(l))
+	if m.GoodTillTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.GoodTillTime)
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.GoodTillBlock != 0 {
+		n += 1 + sovMarket(uint64(m.GoodTillBlock))
+	}
+	if m.PostOnly != 0 {
+		n += 1 + sovMarket(uint64(m.PostOnly))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 1 + sovMarket(uint64(m.SelfTradePrevention))
+	}
+	if m.DisplaySize != nil {
+		l = m.DisplaySize.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.DisplayRemaining != nil {
+		l = m.DisplayRemaining.Size()
+		n += 2 + l + sovMarket(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 2 + sovMarket(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *ConditionalOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Condition != 0 {
+		n += 1 + sovMarket(uint64(m.Condition))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *ExecutionPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MarketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.LastPrice != nil {
+		l = m.LastPrice.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Timestamp)
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradeHistoryLength != 0 {
+		n += 1 + sovMarket(uint64(m.TradeHistoryLength))
+	}
+	if len(m.CandleIntervals) > 0 {
+		for _, e := range m.CandleIntervals {
+			l = github_com_gogo_protobuf_types.SizeOfStdDuration(e)
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.CandleHistoryLength != 0 {
+		n += 1 + sovMarket(uint64(m.CandleHistoryLength))
+	}
+	l = m.MakerFee.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.TakerFee.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.OrderHistoryLength != 0 {
+		n += 1 + sovMarket(uint64(m.OrderHistoryLength))
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	l = m.TickSize.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.LotSize.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.MinNotional.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *InstrumentConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.TickSize.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.LotSize.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.MinNotional.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovMarket(uint64(l))
+	if m.MaxBreaches != 0 {
+		n += 1 + sovMarket(uint64(m.MaxBreaches))
+	}
+	return n
+}
+
+func (m *PriceBand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.ReferencePrice != nil {
+		l = m.ReferencePrice.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Breaches != 0 {
+		n += 1 + sovMarket(uint64(m.Breaches))
+	}
+	return n
+}
+
+func (m *TradingHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Halted)
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Automatic {
+		n += 2
+	}
+	return n
+}
+
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMarket(uint64(m.ID))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.PassiveOrderID != 0 {
+		n += 1 + sovMarket(uint64(m.PassiveOrderID))
+	}
+	if m.AggressiveOrderID != 0 {
+		n += 1 + sovMarket(uint64(m.AggressiveOrderID))
+	}
+	if m.Height != 0 {
+		n += 1 + sovMarket(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *OrderRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMarket(uint64(m.ID))
+	}
+	if m.OrderID != 0 {
+		n += 1 + sovMarket(uint64(m.OrderID))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.ClientOrderID)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovMarket(uint64(m.Status))
+	}
+	l = m.Source.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Destination.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.SourceFilled.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.DestinationFilled.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.AveragePrice.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovMarket(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Closed)
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func sovMarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarket(x uint64) (n int) {
+	return sovMarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Instrument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Instrument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Instrument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceFilled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SourceFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationFilled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DestinationFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTillTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoodTillTime == nil {
+				m.GoodTillTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.GoodTillTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTillBlock", wireType)
+			}
+			m.GoodTillBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GoodTillBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostOnly", wireType)
+			}
+			m.PostOnly = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostOnly |= PostOnlyMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplaySize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.DisplaySize = &v
+			if err := m.DisplaySize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.DisplayRemaining = &v
+			if err := m.DisplayRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConditionalOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionalOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionalOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			m.Condition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Condition |= ConditionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.LastPrice = &v
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeHistoryLength", wireType)
+			}
+			m.TradeHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeHistoryLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleIntervals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CandleIntervals = append(m.CandleIntervals, time.Duration(0))
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&(m.CandleIntervals[len(m.CandleIntervals)-1]), dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleHistoryLength", wireType)
+			}
+			m.CandleHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CandleHistoryLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderHistoryLength", wireType)
+			}
+			m.OrderHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderHistoryLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InstrumentConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InstrumentConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InstrumentConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LotSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBreaches", wireType)
+			}
+			m.MaxBreaches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBreaches |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceBand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceBand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceBand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ReferencePrice = &v
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breaches", wireType)
+			}
+			m.Breaches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Breaches |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovMarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMarket(x uint64) (n int) {
-	return sovMarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Instrument) Unmarshal(dAtA []byte) error {
+func (m *TradingHalt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -783,10 +4840,10 @@ func (m *Instrument) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Instrument: wiretype end group for non-group")
+			return fmt.Errorf("proto: TradingHalt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Instrument: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TradingHalt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -853,6 +4910,59 @@ func (m *Instrument) Unmarshal(dAtA []byte) error {
 			}
 			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Halted, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Automatic", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Automatic = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
@@ -874,7 +4984,7 @@ func (m *Instrument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Order) Unmarshal(dAtA []byte) error {
+func (m *Trade) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -897,10 +5007,10 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -923,10 +5033,10 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			m.TimeInForce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -936,16 +5046,30 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -955,27 +5079,28 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1003,11 +5128,70 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientOrderID = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PassiveOrderID", wireType)
+			}
+			m.PassiveOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PassiveOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggressiveOrderID", wireType)
+			}
+			m.AggressiveOrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggressiveOrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1034,13 +5218,96 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceRemaining", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1068,13 +5335,13 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceFilled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1102,15 +5369,15 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SourceFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1120,28 +5387,29 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationFilled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1169,15 +5437,15 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DestinationFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1187,22 +5455,23 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1227,7 +5496,7 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
+func (m *OrderRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1250,15 +5519,53 @@ func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecutionPlan: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecutionPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderID", wireType)
+			}
+			m.OrderID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1286,15 +5593,13 @@ func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FirstOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientOrderID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1304,31 +5609,46 @@ func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FirstOrder == nil {
-				m.FirstOrder = &Order{}
+			m.ClientOrderID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if err := m.FirstOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecondOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1355,66 +5675,46 @@ func (m *ExecutionPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SecondOrder == nil {
-				m.SecondOrder = &Order{}
-			}
-			if err := m.SecondOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMarket(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMarket
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MarketData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMarket
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Destination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MarketData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MarketData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceFilled", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1442,11 +5742,13 @@ func (m *MarketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			if err := m.SourceFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationFilled", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1474,11 +5776,13 @@ func (m *MarketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = string(dAtA[iNdEx:postIndex])
+			if err := m.DestinationFilled.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AveragePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1506,15 +5810,13 @@ func (m *MarketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.LastPrice = &v
-			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AveragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1541,10 +5843,59 @@ func (m *MarketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timestamp == nil {
-				m.Timestamp = new(time.Time)
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Closed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Closed, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5,7 +5,13 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-const ClientOrderIDMaxLength = 32
+const (
+	ClientOrderIDMaxLength = 32
+	// MaxBatchOrdersItems is the maximum number of cancels, cancel-replaces and limit orders in a batch combined.
+	MaxBatchOrdersItems = 50
+	// MaxCancelAllOrders is the maximum number of orders canceled by a single MsgCancelAllOrders.
+	MaxCancelAllOrders = 100
+)
 
 var (
 	_ sdk.Msg = &MsgAddLimitOrder{}
@@ -13,6 +19,10 @@ var (
 	_ sdk.Msg = &MsgCancelOrder{}
 	_ sdk.Msg = &MsgCancelReplaceLimitOrder{}
 	_ sdk.Msg = &MsgCancelReplaceMarketOrder{}
+	_ sdk.Msg = &MsgBatchOrders{}
+	_ sdk.Msg = &MsgCancelAllOrders{}
+	_ sdk.Msg = &MsgAddConditionalOrder{}
+	_ sdk.Msg = &MsgAddSourceMarketOrder{}
 )
 
 func (m MsgAddMarketOrder) Route() string {
@@ -45,6 +55,10 @@ func (m MsgAddMarketOrder) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", m.Source, m.Destination.Denom)
 	}
 
+	if err := validateSelfTradePrevention(m.SelfTradePrevention); err != nil {
+		return err
+	}
+
 	return validateClientOrderID(m.ClientOrderId)
 }
 
@@ -85,6 +99,22 @@ func (m MsgCancelReplaceLimitOrder) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", m.Source.Denom, m.Destination.Denom)
 	}
 
+	if err := validateExpiry(m.TimeInForce, m.GoodTillTime, m.GoodTillBlock); err != nil {
+		return err
+	}
+
+	if err := validatePostOnly(m.TimeInForce, m.PostOnly); err != nil {
+		return err
+	}
+
+	if err := validateSelfTradePrevention(m.SelfTradePrevention); err != nil {
+		return err
+	}
+
+	if err := validateDisplaySize(m.TimeInForce, m.Source, m.Destination, m.DisplaySize); err != nil {
+		return err
+	}
+
 	err := validateClientOrderID(m.OrigClientOrderId)
 	if err != nil {
 		return err
@@ -158,6 +188,22 @@ func (m MsgAddLimitOrder) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", m.Source.Denom, m.Destination.Denom)
 	}
 
+	if err := validateExpiry(m.TimeInForce, m.GoodTillTime, m.GoodTillBlock); err != nil {
+		return err
+	}
+
+	if err := validatePostOnly(m.TimeInForce, m.PostOnly); err != nil {
+		return err
+	}
+
+	if err := validateSelfTradePrevention(m.SelfTradePrevention); err != nil {
+		return err
+	}
+
+	if err := validateDisplaySize(m.TimeInForce, m.Source, m.Destination, m.DisplaySize); err != nil {
+		return err
+	}
+
 	return validateClientOrderID(m.ClientOrderId)
 }
 
@@ -206,6 +252,10 @@ func (m MsgCancelReplaceMarketOrder) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%s/%s' is not a valid instrument", m.Source, m.Destination.Denom)
 	}
 
+	if err := validateSelfTradePrevention(m.SelfTradePrevention); err != nil {
+		return err
+	}
+
 	err := validateClientOrderID(m.OrigClientOrderId)
 	if err != nil {
 		return err
@@ -225,3 +275,240 @@ func (m MsgCancelReplaceMarketOrder) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{from}
 }
+
+func (m MsgBatchOrders) Route() string {
+	return RouterKey
+}
+
+func (m MsgBatchOrders) Type() string {
+	return "batch_orders"
+}
+
+func (m MsgBatchOrders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	itemCount := len(m.Cancels) + len(m.CancelReplaces) + len(m.LimitOrders)
+	if itemCount == 0 {
+		return sdkerrors.Wrap(ErrInvalidBatch, "batch is empty")
+	}
+
+	if itemCount > MaxBatchOrdersItems {
+		return sdkerrors.Wrapf(ErrInvalidBatch, "batch contains %v items, maximum is %v", itemCount, MaxBatchOrdersItems)
+	}
+
+	batch := m.WithItemOwners()
+	for i, item := range batch.Cancels {
+		if err := m.validateItem(item.Owner, &item); err != nil {
+			return sdkerrors.Wrapf(err, "cancel %v", i)
+		}
+	}
+
+	for i, item := range batch.CancelReplaces {
+		if err := m.validateItem(item.Owner, &item); err != nil {
+			return sdkerrors.Wrapf(err, "cancel-replace %v", i)
+		}
+	}
+
+	for i, item := range batch.LimitOrders {
+		if err := m.validateItem(item.Owner, &item); err != nil {
+			return sdkerrors.Wrapf(err, "limit order %v", i)
+		}
+	}
+
+	return nil
+}
+
+func (m MsgBatchOrders) validateItem(owner string, item sdk.Msg) error {
+	if owner != m.Owner {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "item owner %v differs from batch owner", owner)
+	}
+
+	return item.ValidateBasic()
+}
+
+// WithItemOwners returns a copy of the batch where items without an owner are assigned the owner of the batch.
+func (m MsgBatchOrders) WithItemOwners() MsgBatchOrders {
+	batch := MsgBatchOrders{
+		Owner:          m.Owner,
+		Cancels:        make([]MsgCancelOrder, len(m.Cancels)),
+		CancelReplaces: make([]MsgCancelReplaceLimitOrder, len(m.CancelReplaces)),
+		LimitOrders:    make([]MsgAddLimitOrder, len(m.LimitOrders)),
+	}
+
+	for i, item := range m.Cancels {
+		if item.Owner == "" {
+			item.Owner = m.Owner
+		}
+		batch.Cancels[i] = item
+	}
+
+	for i, item := range m.CancelReplaces {
+		if item.Owner == "" {
+			item.Owner = m.Owner
+		}
+		batch.CancelReplaces[i] = item
+	}
+
+	for i, item := range m.LimitOrders {
+		if item.Owner == "" {
+			item.Owner = m.Owner
+		}
+		batch.LimitOrders[i] = item
+	}
+
+	return batch
+}
+
+func (m MsgBatchOrders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgBatchOrders) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (m MsgCancelAllOrders) Route() string {
+	return RouterKey
+}
+
+func (m MsgCancelAllOrders) Type() string {
+	return "cancel_all_orders"
+}
+
+func (m MsgCancelAllOrders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if m.Source == "" && m.Destination == "" {
+		return nil
+	}
+
+	if sdk.ValidateDenom(m.Source) != nil || sdk.ValidateDenom(m.Destination) != nil || m.Source == m.Destination {
+		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", m.Source, m.Destination)
+	}
+
+	return nil
+}
+
+func (m MsgCancelAllOrders) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelAllOrders) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (m MsgAddConditionalOrder) Route() string {
+	return RouterKey
+}
+
+func (m MsgAddConditionalOrder) Type() string {
+	return "add_conditional_order"
+}
+
+func (m MsgAddConditionalOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if !m.Destination.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "destination amount is invalid: %v", m.Destination.String())
+	}
+
+	if !m.Source.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "source amount is invalid: %v", m.Source.String())
+	}
+
+	if m.Source.Denom == m.Destination.Denom {
+		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", m.Source.Denom, m.Destination.Denom)
+	}
+
+	if err := validateExpiry(m.TimeInForce, m.GoodTillTime, m.GoodTillBlock); err != nil {
+		return err
+	}
+
+	if err := validateCondition(m.Condition, m.TriggerPrice); err != nil {
+		return err
+	}
+
+	if err := validateSelfTradePrevention(m.SelfTradePrevention); err != nil {
+		return err
+	}
+
+	return validateClientOrderID(m.ClientOrderId)
+}
+
+func (m MsgAddConditionalOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAddConditionalOrder) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+func (m MsgAddSourceMarketOrder) Route() string {
+	return RouterKey
+}
+
+func (m MsgAddSourceMarketOrder) Type() string {
+	return "add_source_market_order"
+}
+
+func (m MsgAddSourceMarketOrder) ValidateBasic() error {
+	if m.MaxSlippage.IsNil() || m.MaxSlippage.IsNegative() || m.MaxSlippage.GTE(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidSlippage, "Must be at least zero and less than one")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+
+	if !m.Source.IsValid() || !m.Source.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "source amount is invalid: %v", m.Source.String())
+	}
+
+	if err := sdk.ValidateDenom(m.Destination); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "destination denomination is invalid: %v", m.Destination)
+	}
+
+	if m.Source.Denom == m.Destination {
+		return sdkerrors.Wrapf(ErrInvalidInstrument, "'%v/%v' is not a valid instrument", m.Source.Denom, m.Destination)
+	}
+
+	if m.TimeInForce != TimeInForce_ImmediateOrCancel && m.TimeInForce != TimeInForce_FillOrKill {
+		return sdkerrors.Wrapf(ErrUnknownTimeInForce, "source market orders are either %v or %v", TimeInForce_ImmediateOrCancel, TimeInForce_FillOrKill)
+	}
+
+	if err := validateSelfTradePrevention(m.SelfTradePrevention); err != nil {
+		return err
+	}
+
+	return validateClientOrderID(m.ClientOrderId)
+}
+
+func (m MsgAddSourceMarketOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgAddSourceMarketOrder) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
@@ -0,0 +1,238 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgBatchOrdersValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress([]byte("acc1________________")).String()
+	other := sdk.AccAddress([]byte("acc2________________")).String()
+
+	limitOrder := MsgAddLimitOrder{
+		ClientOrderId: "A",
+		TimeInForce:   TimeInForce_GoodTillCancel,
+		Source:        coin("100eur"),
+		Destination:   coin("120usd"),
+	}
+
+	specs := map[string]struct {
+		msg    MsgBatchOrders
+		expErr error
+	}{
+		"all good": {
+			msg: MsgBatchOrders{
+				Owner:       owner,
+				Cancels:     []MsgCancelOrder{{ClientOrderId: "B"}, {Owner: owner, ClientOrderId: "C"}},
+				LimitOrders: []MsgAddLimitOrder{limitOrder},
+			},
+		},
+		"invalid owner": {
+			msg: MsgBatchOrders{
+				Owner:   "foo",
+				Cancels: []MsgCancelOrder{{ClientOrderId: "B"}},
+			},
+			expErr: sdkerrors.ErrInvalidAddress,
+		},
+		"empty batch": {
+			msg:    MsgBatchOrders{Owner: owner},
+			expErr: ErrInvalidBatch,
+		},
+		"too many items": {
+			msg: func() MsgBatchOrders {
+				m := MsgBatchOrders{Owner: owner}
+				for i := 0; i <= MaxBatchOrdersItems; i++ {
+					m.Cancels = append(m.Cancels, MsgCancelOrder{ClientOrderId: fmt.Sprint(i)})
+				}
+				return m
+			}(),
+			expErr: ErrInvalidBatch,
+		},
+		"item of other owner": {
+			msg: MsgBatchOrders{
+				Owner:   owner,
+				Cancels: []MsgCancelOrder{{Owner: other, ClientOrderId: "B"}},
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		"invalid item": {
+			msg: MsgBatchOrders{
+				Owner: owner,
+				CancelReplaces: []MsgCancelReplaceLimitOrder{{
+					OrigClientOrderId: "A",
+					NewClientOrderId:  "B",
+					TimeInForce:       TimeInForce_GoodTillCancel,
+					Source:            coin("100eur"),
+					Destination:       coin("120eur"),
+				}},
+			},
+			expErr: ErrInvalidInstrument,
+		},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.msg.ValidateBasic()
+			if spec.expErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, spec.expErr)
+		})
+	}
+}
+
+func TestMsgBatchOrdersJSON(t *testing.T) {
+	bz := []byte(`{
+  "cancels": [ { "client_order_id": "order1" } ],
+  "limit_orders": [
+    {
+      "client_order_id": "order4",
+      "time_in_force": "TIME_IN_FORCE_GOOD_TILL_CANCEL",
+      "source": { "denom": "echf", "amount": "500" },
+      "destination": { "denom": "eeur", "amount": "460" }
+    }
+  ]
+}`)
+
+	var msg MsgBatchOrders
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	require.NoError(t, cdc.UnmarshalJSON(bz, &msg))
+
+	msg.Owner = sdk.AccAddress([]byte("acc1________________")).String()
+	require.NoError(t, msg.ValidateBasic())
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+
+	batch := msg.WithItemOwners()
+	require.Equal(t, msg.Owner, batch.Cancels[0].Owner)
+	require.Equal(t, msg.Owner, batch.LimitOrders[0].Owner)
+	require.Equal(t, coin("500echf"), batch.LimitOrders[0].Source)
+	require.Equal(t, TimeInForce_GoodTillCancel, batch.LimitOrders[0].TimeInForce)
+
+	// The original message is left untouched
+	require.Empty(t, msg.Cancels[0].Owner)
+}
+
+func TestMsgCancelAllOrdersValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress([]byte("acc1________________")).String()
+
+	require.NoError(t, MsgCancelAllOrders{Owner: owner}.ValidateBasic())
+	require.NoError(t, MsgCancelAllOrders{Owner: owner, Source: "eur", Destination: "usd"}.ValidateBasic())
+
+	require.ErrorIs(t, MsgCancelAllOrders{Owner: "foo"}.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+	require.ErrorIs(t, MsgCancelAllOrders{Owner: owner, Source: "eur"}.ValidateBasic(), ErrInvalidInstrument)
+	require.ErrorIs(t, MsgCancelAllOrders{Owner: owner, Destination: "usd"}.ValidateBasic(), ErrInvalidInstrument)
+	require.ErrorIs(t, MsgCancelAllOrders{Owner: owner, Source: "eur", Destination: "eur"}.ValidateBasic(), ErrInvalidInstrument)
+}
+
+func TestMsgAddConditionalOrderValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress([]byte("acc1________________")).String()
+
+	valid := func() MsgAddConditionalOrder {
+		return MsgAddConditionalOrder{
+			Owner:         owner,
+			ClientOrderId: "A",
+			TimeInForce:   TimeInForce_GoodTillCancel,
+			Source:        sdk.NewCoin("eur", sdk.NewInt(100)),
+			Destination:   sdk.NewCoin("usd", sdk.NewInt(120)),
+			Condition:     ConditionType_StopLoss,
+			TriggerPrice:  sdk.MustNewDecFromStr("1.1"),
+		}
+	}
+
+	require.NoError(t, valid().ValidateBasic())
+
+	msg := valid()
+	msg.Owner = "foo"
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+
+	msg = valid()
+	msg.Destination.Denom = "eur"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidInstrument)
+
+	msg = valid()
+	msg.Condition = ConditionType_Unspecified
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidConditionalOrder)
+
+	msg = valid()
+	msg.TriggerPrice = sdk.ZeroDec()
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidConditionalOrder)
+
+	msg = valid()
+	msg.TriggerPrice = sdk.Dec{}
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidConditionalOrder)
+}
+
+func TestMsgAddSourceMarketOrderValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress([]byte("acc1________________")).String()
+
+	valid := func() MsgAddSourceMarketOrder {
+		return MsgAddSourceMarketOrder{
+			Owner:         owner,
+			ClientOrderId: "A",
+			TimeInForce:   TimeInForce_ImmediateOrCancel,
+			Source:        sdk.NewCoin("eur", sdk.NewInt(100)),
+			Destination:   "chf",
+			MaxSlippage:   sdk.MustNewDecFromStr("0.05"),
+		}
+	}
+
+	require.NoError(t, valid().ValidateBasic())
+
+	msg := valid()
+	msg.TimeInForce = TimeInForce_FillOrKill
+	require.NoError(t, msg.ValidateBasic())
+
+	msg = valid()
+	msg.Owner = "foo"
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+
+	msg = valid()
+	msg.Destination = "eur"
+	require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidInstrument)
+
+	msg = valid()
+	msg.Source = sdk.NewCoin("eur", sdk.ZeroInt())
+	require.ErrorIs(t, msg.ValidateBasic(), sdkerrors.ErrInvalidCoins)
+
+	msg = valid()
+	msg.TimeInForce = TimeInForce_GoodTillCancel
+	require.ErrorIs(t, msg.ValidateBasic(), ErrUnknownTimeInForce)
+
+	for _, slippage := range []sdk.Dec{{}, sdk.OneDec(), sdk.NewDec(-1)} {
+		msg = valid()
+		msg.MaxSlippage = slippage
+		require.ErrorIs(t, msg.ValidateBasic(), ErrInvalidSlippage)
+	}
+}
+
+func TestSelfTradePreventionValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress([]byte("acc1________________")).String()
+	src, dst := sdk.NewCoin("eur", sdk.NewInt(100)), sdk.NewCoin("usd", sdk.NewInt(120))
+	invalid := SelfTradePrevention(4)
+
+	limit := MsgAddLimitOrder{Owner: owner, ClientOrderId: "A", TimeInForce: TimeInForce_GoodTillCancel, Source: src, Destination: dst}
+	limit.SelfTradePrevention = SelfTradePrevention_CancelBoth
+	require.NoError(t, limit.ValidateBasic())
+	limit.SelfTradePrevention = invalid
+	require.ErrorIs(t, limit.ValidateBasic(), ErrInvalidSelfTradePrevention)
+
+	market := MsgAddMarketOrder{Owner: owner, ClientOrderId: "A", TimeInForce: TimeInForce_GoodTillCancel, Source: "eur", Destination: dst, MaxSlippage: sdk.MustNewDecFromStr("0.05")}
+	market.SelfTradePrevention = SelfTradePrevention_CancelOldest
+	require.NoError(t, market.ValidateBasic())
+	market.SelfTradePrevention = invalid
+	require.ErrorIs(t, market.ValidateBasic(), ErrInvalidSelfTradePrevention)
+
+	for _, s := range []string{"", "disabled", "cancel-newest", "cancel-oldest", "cancel-both"} {
+		_, err := SelfTradePreventionFromString(s)
+		require.NoError(t, err, s)
+	}
+	_, err := SelfTradePreventionFromString("cancel-all")
+	require.Error(t, err)
+}
@@ -0,0 +1,216 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+const (
+	DefaultTradeHistoryLength  = uint32(1000)
+	DefaultCandleHistoryLength = uint32(500)
+	DefaultOrderHistoryLength  = uint32(100)
+)
+
+// Parameter store keys
+var (
+	KeyTradeHistoryLength  = []byte("TradeHistoryLength")
+	KeyCandleIntervals     = []byte("CandleIntervals")
+	KeyCandleHistoryLength = []byte("CandleHistoryLength")
+	KeyMakerFee            = []byte("MakerFee")
+	KeyTakerFee            = []byte("TakerFee")
+	KeyOrderHistoryLength  = []byte("OrderHistoryLength")
+	KeyCircuitBreakers     = []byte("CircuitBreakers")
+	KeyTickSize            = []byte("TickSize")
+	KeyLotSize             = []byte("LotSize")
+	KeyMinNotional         = []byte("MinNotional")
+
+	DefaultCandleIntervals = []time.Duration{time.Minute, time.Hour, 24 * time.Hour}
+)
+
+var _ paramtypes.ParamSet = &Params{}
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func DefaultParams() Params {
+	return Params{
+		TradeHistoryLength:  DefaultTradeHistoryLength,
+		CandleIntervals:     DefaultCandleIntervals,
+		CandleHistoryLength: DefaultCandleHistoryLength,
+		MakerFee:            sdk.ZeroDec(),
+		TakerFee:            sdk.ZeroDec(),
+		OrderHistoryLength:  DefaultOrderHistoryLength,
+		TickSize:            sdk.ZeroDec(),
+		LotSize:             sdk.ZeroInt(),
+		MinNotional:         sdk.ZeroInt(),
+	}
+}
+
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyTradeHistoryLength, &p.TradeHistoryLength, validateHistoryLength),
+		paramtypes.NewParamSetPair(KeyCandleIntervals, &p.CandleIntervals, validateCandleIntervals),
+		paramtypes.NewParamSetPair(KeyCandleHistoryLength, &p.CandleHistoryLength, validateHistoryLength),
+		paramtypes.NewParamSetPair(KeyMakerFee, &p.MakerFee, validateFee),
+		paramtypes.NewParamSetPair(KeyTakerFee, &p.TakerFee, validateFee),
+		paramtypes.NewParamSetPair(KeyOrderHistoryLength, &p.OrderHistoryLength, validateHistoryLength),
+		paramtypes.NewParamSetPair(KeyCircuitBreakers, &p.CircuitBreakers, validateCircuitBreakers),
+		paramtypes.NewParamSetPair(KeyTickSize, &p.TickSize, validateTickSize),
+		paramtypes.NewParamSetPair(KeyLotSize, &p.LotSize, validateOrderSize),
+		paramtypes.NewParamSetPair(KeyMinNotional, &p.MinNotional, validateOrderSize),
+	}
+}
+
+func (p Params) Validate() error {
+	if err := validateHistoryLength(p.TradeHistoryLength); err != nil {
+		return err
+	}
+
+	if err := validateCandleIntervals(p.CandleIntervals); err != nil {
+		return err
+	}
+
+	if err := validateHistoryLength(p.CandleHistoryLength); err != nil {
+		return err
+	}
+
+	if err := validateFee(p.MakerFee); err != nil {
+		return err
+	}
+
+	if err := validateFee(p.TakerFee); err != nil {
+		return err
+	}
+
+	if err := validateHistoryLength(p.OrderHistoryLength); err != nil {
+		return err
+	}
+
+	if err := validateCircuitBreakers(p.CircuitBreakers); err != nil {
+		return err
+	}
+
+	if err := validateTickSize(p.TickSize); err != nil {
+		return err
+	}
+
+	if err := validateOrderSize(p.LotSize); err != nil {
+		return err
+	}
+
+	return validateOrderSize(p.MinNotional)
+}
+
+// DefaultInstrumentConfig returns the configuration of the src/dst instrument if it has none of its own.
+func (p Params) DefaultInstrumentConfig(src, dst string) InstrumentConfig {
+	return InstrumentConfig{
+		Source:      src,
+		Destination: dst,
+		TickSize:    p.TickSize,
+		LotSize:     p.LotSize,
+		MinNotional: p.MinNotional,
+	}
+}
+
+// GetCircuitBreaker returns the circuit breaker of the instrument in either direction. Inverted is set if the breaker
+// is configured for the dst/src direction.
+func (p Params) GetCircuitBreaker(src, dst string) (breaker CircuitBreaker, inverted bool, found bool) {
+	for _, cb := range p.CircuitBreakers {
+		switch {
+		case cb.Source == src && cb.Destination == dst:
+			return cb, false, true
+		case cb.Source == dst && cb.Destination == src:
+			return cb, true, true
+		}
+	}
+
+	return CircuitBreaker{}, false, false
+}
+
+func validateHistoryLength(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// Zero disables the history.
+	return nil
+}
+
+func validateCandleIntervals(i interface{}) error {
+	intervals, ok := i.([]time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[time.Duration]bool)
+	for _, interval := range intervals {
+		if interval < time.Second || interval%time.Second != 0 {
+			return fmt.Errorf("candle interval must be a positive number of seconds: %v", interval)
+		}
+
+		if seen[interval] {
+			return fmt.Errorf("duplicate candle interval: %v", interval)
+		}
+		seen[interval] = true
+	}
+
+	return nil
+}
+
+func validateFee(i interface{}) error {
+	fee, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// A fee rate below one ensures that traders always receive part of a fill.
+	if fee.IsNil() || fee.IsNegative() || fee.GTE(sdk.OneDec()) {
+		return fmt.Errorf("fee rate must be at least zero and less than one: %v", fee)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakers(i interface{}) error {
+	breakers, ok := i.([]CircuitBreaker)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[[2]string]bool)
+	for _, cb := range breakers {
+		if err := sdk.ValidateDenom(cb.Source); err != nil {
+			return fmt.Errorf("circuit breaker has an invalid source denomination: %w", err)
+		}
+
+		if err := sdk.ValidateDenom(cb.Destination); err != nil {
+			return fmt.Errorf("circuit breaker has an invalid destination denomination: %w", err)
+		}
+
+		if cb.Source == cb.Destination {
+			return fmt.Errorf("circuit breaker for '%v/%v' is not a valid instrument", cb.Source, cb.Destination)
+		}
+
+		// A deviation below one keeps the lower bound of the band positive in both directions.
+		if cb.MaxDeviation.IsNil() || !cb.MaxDeviation.IsPositive() || cb.MaxDeviation.GTE(sdk.OneDec()) {
+			return fmt.Errorf("circuit breaker for '%v/%v' must have a deviation above zero and below one: %v", cb.Source, cb.Destination, cb.MaxDeviation)
+		}
+
+		if cb.Window <= 0 {
+			return fmt.Errorf("circuit breaker for '%v/%v' must have a positive window: %v", cb.Source, cb.Destination, cb.Window)
+		}
+
+		denom1, denom2 := GetTradePair(cb.Source, cb.Destination)
+		key := [2]string{denom1, denom2}
+		if seen[key] {
+			return fmt.Errorf("duplicate circuit breaker for '%v/%v'", cb.Source, cb.Destination)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -14,9 +14,31 @@ func (q QueryInstrumentResponse) String() string {
 		sb.WriteString(order.String())
 	}
 
+	if len(q.Bids) > 0 {
+		sb.WriteString(fmt.Sprintf("%v => %v\n", q.Destination, q.Source))
+
+		for _, order := range q.Bids {
+			sb.WriteString(order.String())
+		}
+	}
+
 	return sb.String()
 }
 
+// Convert from the order side string representation to the internal enum type. Case insensitive.
+func OrderSideFromString(side string) (OrderSide, error) {
+	switch strings.ToLower(side) {
+	case "", "asks":
+		return OrderSide_Asks, nil
+	case "bids":
+		return OrderSide_Bids, nil
+	case "both":
+		return OrderSide_Both, nil
+	}
+
+	return 0, fmt.Errorf("unknown order side: %v", side)
+}
+
 func (q QueryOrderResponse) String() string {
 	return fmt.Sprintf(" - %v %v %v %v\n", q.ID, q.Price, q.SourceRemaining, q.Owner)
 }
@@ -42,3 +64,23 @@ func (q QueryInstrumentsResponse) String() string {
 func (q QueryInstrumentsResponse_Element) String() string {
 	return fmt.Sprintf("%v => %v", q.Source, q.Destination)
 }
+
+func (q QueryDepthResponse) String() string {
+	sb := new(strings.Builder)
+
+	sb.WriteString(fmt.Sprintf("%v => %v\nAsks:\n", q.Source, q.Destination))
+	for _, level := range q.Asks {
+		sb.WriteString(level.String())
+	}
+
+	sb.WriteString("Bids:\n")
+	for _, level := range q.Bids {
+		sb.WriteString(level.String())
+	}
+
+	return sb.String()
+}
+
+func (l PriceLevel) String() string {
+	return fmt.Sprintf(" - %v %v (%d orders)\n", l.Price, l.SourceRemaining, l.OrderCount)
+}
@@ -1,51 +1,195 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/jsonpb"
 )
 
+// orderJSON is the JSON representation of an order, in which all numbers are encoded as strings.
+type orderJSON struct {
+	ID                  string   `json:"order_id"`
+	TimeInForce         string   `json:"time_in_force"`
+	Owner               string   `json:"owner"`
+	ClientOrderID       string   `json:"client_order_id"`
+	Price               string   `json:"price"`
+	Source              sdk.Coin `json:"source"`
+	SourceRemaining     sdk.Int  `json:"source_remaining"`
+	SourceFilled        sdk.Int  `json:"source_filled"`
+	Destination         sdk.Coin `json:"destination"`
+	DestinationFilled   sdk.Int  `json:"destination_filled"`
+	Created             string   `json:"created"`
+	GoodTillTime        string   `json:"good_till_time"`
+	GoodTillBlock       string   `json:"good_till_block"`
+	PostOnly            string   `json:"post_only"`
+	SelfTradePrevention string   `json:"self_trade_prevention"`
+	DisplaySize         string   `json:"display_size"`
+	DisplayRemaining    string   `json:"display_remaining"`
+	Priority            string   `json:"priority"`
+}
+
 func (o Order) MarshalJSON() ([]byte, error) {
-	s := fmt.Sprintf(`
-{
-  "order_id": "%v",
-  "time_in_force" : "%v",
-  "owner": "%v",
-  "client_order_id": "%v",
-  "price": "%v",
-  "source": {
-    "denom": "%v",
-    "amount": "%v"
-  },
-  "source_remaining": "%v",
-  "source_filled": "%v",
-  "destination": {
-    "denom": "%v",
-    "amount": "%v"
-  },
-  "destination_filled": "%v"
-}
-`,
-		o.ID,
-		o.TimeInForce,
-		o.Owner,
-		o.ClientOrderID,
-		o.Price().String(),
-		o.Source.Denom,
-		o.Source.Amount,
-		o.SourceRemaining,
-		o.SourceFilled,
-		o.Destination.Denom,
-		o.Destination.Amount,
-		o.DestinationFilled,
-	)
-
-	return []byte(s), nil
+	return json.Marshal(orderJSON{
+		ID:                  strconv.FormatUint(o.ID, 10),
+		TimeInForce:         o.TimeInForce.String(),
+		Owner:               o.Owner,
+		ClientOrderID:       o.ClientOrderID,
+		Price:               o.Price().String(),
+		Source:              o.Source,
+		SourceRemaining:     o.SourceRemaining,
+		SourceFilled:        o.SourceFilled,
+		Destination:         o.Destination,
+		DestinationFilled:   o.DestinationFilled,
+		Created:             o.Created.UTC().Format(time.RFC3339Nano),
+		GoodTillTime:        formatGoodTillTime(o.GoodTillTime),
+		GoodTillBlock:       strconv.FormatInt(o.GoodTillBlock, 10),
+		PostOnly:            o.PostOnly.String(),
+		SelfTradePrevention: o.SelfTradePrevention.String(),
+		DisplaySize:         formatOptionalInt(o.DisplaySize),
+		DisplayRemaining:    formatOptionalInt(o.DisplayRemaining),
+		Priority:            strconv.FormatUint(o.Priority, 10),
+	})
+}
+
+// UnmarshalJSON is the inverse of MarshalJSON, which allows orders to be
+// restored from an exported genesis file. The price is derived and ignored.
+func (o *Order) UnmarshalJSON(bz []byte) error {
+	var raw orderJSON
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return err
+	}
+
+	id, err := strconv.ParseUint(raw.ID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid order id %q: %w", raw.ID, err)
+	}
+
+	tif, found := TimeInForce_value[raw.TimeInForce]
+	if !found {
+		return fmt.Errorf("unknown time in force %q", raw.TimeInForce)
+	}
+
+	var created time.Time
+	if raw.Created != "" {
+		if created, err = time.Parse(time.RFC3339Nano, raw.Created); err != nil {
+			return fmt.Errorf("invalid order creation time %q: %w", raw.Created, err)
+		}
+	}
+
+	var goodTillTime *time.Time
+	if raw.GoodTillTime != "" {
+		t, err := time.Parse(time.RFC3339Nano, raw.GoodTillTime)
+		if err != nil {
+			return fmt.Errorf("invalid order expiry time %q: %w", raw.GoodTillTime, err)
+		}
+		goodTillTime = &t
+	}
+
+	var goodTillBlock int64
+	if raw.GoodTillBlock != "" {
+		if goodTillBlock, err = strconv.ParseInt(raw.GoodTillBlock, 10, 64); err != nil {
+			return fmt.Errorf("invalid order expiry block %q: %w", raw.GoodTillBlock, err)
+		}
+	}
+
+	postOnly := PostOnlyMode_Disabled
+	if raw.PostOnly != "" {
+		mode, found := PostOnlyMode_value[raw.PostOnly]
+		if !found {
+			return fmt.Errorf("unknown post-only mode %q", raw.PostOnly)
+		}
+		postOnly = PostOnlyMode(mode)
+	}
+
+	selfTradePrevention := SelfTradePrevention_Disabled
+	if raw.SelfTradePrevention != "" {
+		mode, found := SelfTradePrevention_value[raw.SelfTradePrevention]
+		if !found {
+			return fmt.Errorf("unknown self-trade prevention mode %q", raw.SelfTradePrevention)
+		}
+		selfTradePrevention = SelfTradePrevention(mode)
+	}
+
+	displaySize, err := parseOptionalInt(raw.DisplaySize)
+	if err != nil {
+		return fmt.Errorf("invalid order display size %q: %w", raw.DisplaySize, err)
+	}
+
+	displayRemaining, err := parseOptionalInt(raw.DisplayRemaining)
+	if err != nil {
+		return fmt.Errorf("invalid order display remaining %q: %w", raw.DisplayRemaining, err)
+	}
+
+	var priority uint64
+	if raw.Priority != "" {
+		if priority, err = strconv.ParseUint(raw.Priority, 10, 64); err != nil {
+			return fmt.Errorf("invalid order priority %q: %w", raw.Priority, err)
+		}
+	}
+
+	*o = Order{
+		ID:                  id,
+		TimeInForce:         TimeInForce(tif),
+		Owner:               raw.Owner,
+		ClientOrderID:       raw.ClientOrderID,
+		Source:              raw.Source,
+		SourceRemaining:     raw.SourceRemaining,
+		SourceFilled:        raw.SourceFilled,
+		Destination:         raw.Destination,
+		DestinationFilled:   raw.DestinationFilled,
+		Created:             created,
+		GoodTillTime:        goodTillTime,
+		GoodTillBlock:       goodTillBlock,
+		PostOnly:            postOnly,
+		SelfTradePrevention: selfTradePrevention,
+		DisplaySize:         displaySize,
+		DisplayRemaining:    displayRemaining,
+		Priority:            priority,
+	}
+
+	return nil
+}
+
+// formatOptionalInt formats an optional amount, which is left empty if unset.
+func formatOptionalInt(i *sdk.Int) string {
+	if i == nil {
+		return ""
+	}
+
+	return i.String()
+}
+
+func parseOptionalInt(s string) (*sdk.Int, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	i, ok := sdk.NewIntFromString(s)
+	if !ok {
+		return nil, fmt.Errorf("not an integer")
+	}
+
+	return &i, nil
+}
+
+func formatGoodTillTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// UnmarshalJSONPB makes the protobuf JSON codec use the same representation as MarshalJSON.
+func (o *Order) UnmarshalJSONPB(_ *jsonpb.Unmarshaler, bz []byte) error {
+	return o.UnmarshalJSON(bz)
 }
 
 // Signals whether the order can be meaningfully executed, ie will pay for more than one unit of the destination token.
@@ -54,10 +198,20 @@ func (o Order) IsFilled() bool {
 }
 
 func (o Order) IsValid() error {
-	switch o.TimeInForce {
-	case TimeInForce_GoodTillCancel, TimeInForce_FillOrKill, TimeInForce_ImmediateOrCancel:
-	default:
-		return sdkerrors.Wrapf(ErrUnknownTimeInForce, "Unknown 'time in force' specified : %v", o.TimeInForce)
+	if err := validateExpiry(o.TimeInForce, o.GoodTillTime, o.GoodTillBlock); err != nil {
+		return err
+	}
+
+	if err := validatePostOnly(o.TimeInForce, o.PostOnly); err != nil {
+		return err
+	}
+
+	if err := validateSelfTradePrevention(o.SelfTradePrevention); err != nil {
+		return err
+	}
+
+	if err := validateDisplaySize(o.TimeInForce, o.Source, o.Destination, o.DisplaySize); err != nil {
+		return err
 	}
 
 	if o.Source.Amount.LTE(sdk.ZeroInt()) {
@@ -75,6 +229,75 @@ func (o Order) IsValid() error {
 	return nil
 }
 
+// IsExpired returns true if the order's good-till time or block has passed. An
+// order with a good-till time expires once the block time reaches it, while an
+// order with a good-till block may still be matched in that block.
+func (o Order) IsExpired(blockTime time.Time, blockHeight int64) bool {
+	switch o.TimeInForce {
+	case TimeInForce_GoodTillTime:
+		return !blockTime.Before(*o.GoodTillTime)
+	case TimeInForce_GoodTillBlock:
+		return blockHeight > o.GoodTillBlock
+	}
+
+	return false
+}
+
+// IsIceberg returns whether only a slice of the order is displayed in the book at a time.
+func (o Order) IsIceberg() bool {
+	return o.DisplaySize != nil && o.DisplaySize.IsPositive()
+}
+
+// VisibleRemaining returns the part of SourceRemaining that is displayed in the book and can be matched.
+func (o Order) VisibleRemaining() sdk.Int {
+	if !o.IsIceberg() {
+		return o.SourceRemaining
+	}
+
+	return sdk.MinInt(o.SourceRemaining, *o.DisplayRemaining)
+}
+
+// Redacted returns the order as it is displayed to other participants. An iceberg order is shown as if its displayed
+// slice was all that remained, with its source and destination scaled accordingly, and without its display settings.
+// The destination is rounded up, so the displayed price is never below the limit price of the order.
+func (o Order) Redacted() Order {
+	if !o.IsIceberg() {
+		return o
+	}
+
+	visible := o.VisibleRemaining()
+	source := o.SourceFilled.Add(visible)
+
+	scaled := o.Destination.Amount.Mul(source)
+	destination := scaled.Quo(o.Source.Amount)
+	if !scaled.Mod(o.Source.Amount).IsZero() {
+		destination = destination.AddRaw(1)
+	}
+
+	o.Destination = sdk.NewCoin(o.Destination.Denom, destination)
+	o.Source = sdk.NewCoin(o.Source.Denom, source)
+	o.SourceRemaining = visible
+	o.DisplaySize = nil
+	o.DisplayRemaining = nil
+
+	return o
+}
+
+// NeedsReplenishment signals that the displayed slice of an iceberg order can no longer be meaningfully executed, while
+// the order itself can.
+func (o Order) NeedsReplenishment() bool {
+	return o.IsIceberg() && !o.IsFilled() && o.DisplayRemaining.ToDec().Mul(o.Price()).LT(sdk.OneDec())
+}
+
+// PriorityID orders the order among the orders with the same price in the book.
+func (o Order) PriorityID() uint64 {
+	if o.Priority != 0 {
+		return o.Priority
+	}
+
+	return o.ID
+}
+
 func (o Order) Price() sdk.Dec {
 	return o.Destination.Amount.ToDec().Quo(o.Source.Amount.ToDec())
 }
@@ -84,21 +307,33 @@ func (o Order) String() string {
 }
 
 func (ep ExecutionPlan) DestinationCapacity() sdk.Dec {
-	if ep.FirstOrder == nil {
+	if len(ep.Orders) == 0 {
 		return sdk.ZeroDec()
 	}
 
 	// Find capacity of the first order.
-	res := ep.FirstOrder.SourceRemaining.ToDec().Mul(ep.FirstOrder.Price())
-	res = sdk.MinDec(res, ep.FirstOrder.Destination.Amount.Sub(ep.FirstOrder.DestinationFilled).ToDec())
+	first := ep.Orders[0]
+	res := first.VisibleRemaining().ToDec().Mul(first.Price())
+	res = sdk.MinDec(res, first.Destination.Amount.Sub(first.DestinationFilled).ToDec())
 
-	if ep.SecondOrder != nil {
-		// Convert first order capacity to second order destination.
-		res = res.Mul(ep.SecondOrder.Price())
+	for _, o := range ep.Orders[1:] {
+		// Convert the capacity so far to the destination of the next order.
+		res = res.Mul(o.Price())
 
 		// Determine which of the orders have the lowest capacity.
-		res = sdk.MinDec(res, ep.SecondOrder.SourceRemaining.ToDec().Mul(ep.SecondOrder.Price()))
-		res = sdk.MinDec(res, ep.SecondOrder.Destination.Amount.Sub(ep.SecondOrder.DestinationFilled).ToDec())
+		res = sdk.MinDec(res, o.VisibleRemaining().ToDec().Mul(o.Price()))
+		res = sdk.MinDec(res, o.Destination.Amount.Sub(o.DestinationFilled).ToDec())
+	}
+
+	return res
+}
+
+// OrdersOf returns the orders of the plan that belong to owner.
+func (ep ExecutionPlan) OrdersOf(owner string) (res []*Order) {
+	for _, o := range ep.Orders {
+		if o.Owner == owner {
+			res = append(res, o)
+		}
 	}
 
 	return res
@@ -108,11 +343,7 @@ func (ep ExecutionPlan) String() string {
 	var buf strings.Builder
 
 	var capacityDenom string
-	for _, o := range []*Order{ep.FirstOrder, ep.SecondOrder} {
-		if o == nil {
-			continue
-		}
-
+	for _, o := range ep.Orders {
 		capacityDenom = o.Destination.Denom
 		buf.WriteString(fmt.Sprintf(" - %v\n", o.String()))
 	}
@@ -128,6 +359,21 @@ func NewOrder(
 	src, dst sdk.Coin,
 	seller sdk.AccAddress,
 	clientOrderId string,
+) (Order, error) {
+	return NewOrderWithExpiry(createdTm, timeInForce, src, dst, seller, clientOrderId, nil, 0)
+}
+
+// NewOrderWithExpiry creates an order that is removed from the book once the
+// good-till time or block has passed. Only one of them can be used, depending
+// on the time in force.
+func NewOrderWithExpiry(
+	createdTm time.Time,
+	timeInForce TimeInForce,
+	src, dst sdk.Coin,
+	seller sdk.AccAddress,
+	clientOrderId string,
+	goodTillTime *time.Time,
+	goodTillBlock int64,
 ) (Order, error) {
 	if src.Amount.LTE(sdk.ZeroInt()) || dst.Amount.LTE(sdk.ZeroInt()) {
 		return Order{}, sdkerrors.Wrapf(ErrInvalidPrice, "Order price is invalid: %s -> %s", src.Amount, dst.Amount)
@@ -145,6 +391,8 @@ func NewOrder(
 		Destination:       dst,
 		DestinationFilled: sdk.ZeroInt(),
 		Created:           createdTm,
+		GoodTillTime:      goodTillTime,
+		GoodTillBlock:     goodTillBlock,
 	}
 
 	if err := o.IsValid(); err != nil {
@@ -165,7 +413,122 @@ func TimeInForceFromString(p string) (TimeInForce, error) {
 		return TimeInForce_ImmediateOrCancel, nil
 	case "gtc":
 		return TimeInForce_GoodTillCancel, nil
+	case "gtt":
+		return TimeInForce_GoodTillTime, nil
+	case "gtb":
+		return TimeInForce_GoodTillBlock, nil
 	}
 
 	return 0, fmt.Errorf("unknown time-in-force value: %v", p)
 }
+
+// Convert from the post-only string representation to the internal enum type. Case insensitive.
+func PostOnlyModeFromString(p string) (PostOnlyMode, error) {
+	p = strings.ToLower(p)
+
+	switch p {
+	case "", "disabled":
+		return PostOnlyMode_Disabled, nil
+	case "reject":
+		return PostOnlyMode_Reject, nil
+	case "reprice":
+		return PostOnlyMode_Reprice, nil
+	}
+
+	return 0, fmt.Errorf("unknown post-only value: %v", p)
+}
+
+// validatePostOnly verifies that post-only orders are able to rest in the order book.
+func validatePostOnly(timeInForce TimeInForce, postOnly PostOnlyMode) error {
+	switch postOnly {
+	case PostOnlyMode_Disabled:
+		return nil
+	case PostOnlyMode_Reject, PostOnlyMode_Reprice:
+		if timeInForce == TimeInForce_ImmediateOrCancel || timeInForce == TimeInForce_FillOrKill {
+			return sdkerrors.Wrapf(ErrInvalidPostOnly, "Post-only cannot be used with time in force %v", timeInForce)
+		}
+		return nil
+	}
+
+	return sdkerrors.Wrapf(ErrInvalidPostOnly, "Unknown post-only mode: %v", postOnly)
+}
+
+// Convert from the self-trade prevention string representation to the internal enum type. Case insensitive.
+func SelfTradePreventionFromString(p string) (SelfTradePrevention, error) {
+	p = strings.ToLower(p)
+
+	switch p {
+	case "", "disabled":
+		return SelfTradePrevention_Disabled, nil
+	case "cancel-newest":
+		return SelfTradePrevention_CancelNewest, nil
+	case "cancel-oldest":
+		return SelfTradePrevention_CancelOldest, nil
+	case "cancel-both":
+		return SelfTradePrevention_CancelBoth, nil
+	}
+
+	return 0, fmt.Errorf("unknown self-trade prevention value: %v", p)
+}
+
+func validateSelfTradePrevention(mode SelfTradePrevention) error {
+	if _, found := SelfTradePrevention_name[int32(mode)]; !found {
+		return sdkerrors.Wrapf(ErrInvalidSelfTradePrevention, "Unknown self-trade prevention mode: %v", mode)
+	}
+
+	return nil
+}
+
+// CancelsNewest returns whether the remainder of an aggressive order is canceled when it would match its owner's order.
+func (m SelfTradePrevention) CancelsNewest() bool {
+	return m == SelfTradePrevention_CancelNewest || m == SelfTradePrevention_CancelBoth
+}
+
+// CancelsOldest returns whether a passive order is canceled when it would match an order of its owner.
+func (m SelfTradePrevention) CancelsOldest() bool {
+	return m == SelfTradePrevention_CancelOldest || m == SelfTradePrevention_CancelBoth
+}
+
+// validateDisplaySize verifies that the displayed slice of an iceberg order hides part of the order and is large enough
+// to be executed. Regular orders have no display size.
+func validateDisplaySize(timeInForce TimeInForce, src, dst sdk.Coin, displaySize *sdk.Int) error {
+	if displaySize == nil {
+		return nil
+	}
+
+	if !displaySize.IsPositive() || displaySize.GTE(src.Amount) {
+		return sdkerrors.Wrapf(ErrInvalidDisplaySize, "Display size %v must be positive and less than the source amount %v", displaySize, src.Amount)
+	}
+
+	if timeInForce == TimeInForce_ImmediateOrCancel || timeInForce == TimeInForce_FillOrKill {
+		return sdkerrors.Wrapf(ErrInvalidDisplaySize, "Iceberg orders cannot be used with time in force %v", timeInForce)
+	}
+
+	if src.Amount.IsPositive() && displaySize.ToDec().Mul(dst.Amount.ToDec()).Quo(src.Amount.ToDec()).LT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidDisplaySize, "Display size %v is too small to buy one unit of %v", displaySize, dst.Denom)
+	}
+
+	return nil
+}
+
+// validateExpiry verifies that an expiry is given if, and only if, the time in force requires one.
+func validateExpiry(timeInForce TimeInForce, goodTillTime *time.Time, goodTillBlock int64) error {
+	switch timeInForce {
+	case TimeInForce_GoodTillCancel, TimeInForce_FillOrKill, TimeInForce_ImmediateOrCancel:
+		if goodTillTime != nil || goodTillBlock != 0 {
+			return sdkerrors.Wrapf(ErrInvalidExpiry, "Expiry cannot be used with time in force %v", timeInForce)
+		}
+	case TimeInForce_GoodTillTime:
+		if goodTillTime == nil || goodTillBlock != 0 {
+			return sdkerrors.Wrapf(ErrInvalidExpiry, "Time in force %v requires a good-till time only", timeInForce)
+		}
+	case TimeInForce_GoodTillBlock:
+		if goodTillTime != nil || goodTillBlock <= 0 {
+			return sdkerrors.Wrapf(ErrInvalidExpiry, "Time in force %v requires a positive good-till block only", timeInForce)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrUnknownTimeInForce, "Unknown 'time in force' specified : %v", timeInForce)
+	}
+
+	return nil
+}
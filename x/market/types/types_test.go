@@ -119,10 +119,152 @@ func TestTimeInForce(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, TimeInForce_FillOrKill, tif)
 
+	tif, err = TimeInForceFromString("gtt")
+	require.NoError(t, err)
+	require.Equal(t, TimeInForce_GoodTillTime, tif)
+
+	tif, err = TimeInForceFromString("GTB")
+	require.NoError(t, err)
+	require.Equal(t, TimeInForce_GoodTillBlock, tif)
+
 	_, err = TimeInForceFromString("f0k")
 	require.Error(t, err)
 }
 
+func TestPostOnlyMode(t *testing.T) {
+	mode, err := PostOnlyModeFromString("")
+	require.NoError(t, err)
+	require.Equal(t, PostOnlyMode_Disabled, mode)
+
+	mode, err = PostOnlyModeFromString("Reprice")
+	require.NoError(t, err)
+	require.Equal(t, PostOnlyMode_Reprice, mode)
+
+	_, err = PostOnlyModeFromString("maybe")
+	require.Error(t, err)
+
+	o, err := NewOrder(time.Now(), TimeInForce_GoodTillCancel, coin("100eur"), coin("120usd"), []byte("acc"), "A")
+	require.NoError(t, err)
+	o.PostOnly = PostOnlyMode_Reject
+	require.NoError(t, o.IsValid())
+
+	bz, err := o.MarshalJSON()
+	require.NoError(t, err)
+	var o2 Order
+	require.NoError(t, o2.UnmarshalJSON(bz))
+	require.Equal(t, PostOnlyMode_Reject, o2.PostOnly)
+
+	o.TimeInForce = TimeInForce_FillOrKill
+	require.ErrorIs(t, o.IsValid(), ErrInvalidPostOnly)
+
+	o.TimeInForce = TimeInForce_GoodTillCancel
+	o.PostOnly = PostOnlyMode(7)
+	require.ErrorIs(t, o.IsValid(), ErrInvalidPostOnly)
+}
+
+func TestIcebergOrderValidation(t *testing.T) {
+	o, err := NewOrder(time.Now(), TimeInForce_GoodTillCancel, coin("1000eur"), coin("120usd"), []byte("acc"), "A")
+	require.NoError(t, err)
+	require.False(t, o.IsIceberg())
+	require.Equal(t, o.SourceRemaining, o.VisibleRemaining())
+
+	displaySize, displayRemaining, priority := sdk.NewInt(100), sdk.NewInt(40), uint64(7)
+	o.DisplaySize, o.DisplayRemaining, o.Priority = &displaySize, &displayRemaining, priority
+	require.NoError(t, o.IsValid())
+	require.True(t, o.IsIceberg())
+	require.Equal(t, sdk.NewInt(40), o.VisibleRemaining())
+	require.Equal(t, priority, o.PriorityID())
+
+	// 40eur of 1000eur scales the destination to 4.8usd, which is rounded up to keep the price at or above the limit
+	redacted := o.Redacted()
+	require.Equal(t, coin("40eur"), redacted.Source)
+	require.Equal(t, coin("5usd"), redacted.Destination)
+	require.True(t, redacted.Price().GTE(o.Price()))
+	require.Equal(t, sdk.NewInt(40), redacted.SourceRemaining)
+	require.Nil(t, redacted.DisplaySize)
+	require.Nil(t, redacted.DisplayRemaining)
+
+	// A display size that divides the amounts evenly keeps the exact price
+	displayRemaining = sdk.NewInt(50)
+	redacted = o.Redacted()
+	require.Equal(t, coin("50eur"), redacted.Source)
+	require.Equal(t, coin("6usd"), redacted.Destination)
+	require.Equal(t, o.Price(), redacted.Price())
+	displayRemaining = sdk.NewInt(40)
+
+	bz, err := o.MarshalJSON()
+	require.NoError(t, err)
+	var o2 Order
+	require.NoError(t, o2.UnmarshalJSON(bz))
+	require.Equal(t, displaySize, *o2.DisplaySize)
+	require.Equal(t, displayRemaining, *o2.DisplayRemaining)
+	require.Equal(t, priority, o2.Priority)
+
+	// Regular orders are restored without a display size
+	o.DisplaySize, o.DisplayRemaining = nil, nil
+	bz, err = o.MarshalJSON()
+	require.NoError(t, err)
+	require.NoError(t, o2.UnmarshalJSON(bz))
+	require.Nil(t, o2.DisplaySize)
+
+	for _, size := range []int64{0, 1000, 5} {
+		invalid := sdk.NewInt(size)
+		o.DisplaySize = &invalid
+		require.ErrorIs(t, o.IsValid(), ErrInvalidDisplaySize, size)
+	}
+
+	o.DisplaySize = &displaySize
+	o.TimeInForce = TimeInForce_ImmediateOrCancel
+	require.ErrorIs(t, o.IsValid(), ErrInvalidDisplaySize)
+}
+
+func TestOrderExpiry(t *testing.T) {
+	now := time.Now()
+	expiry := now.Add(time.Hour)
+
+	specs := map[string]struct {
+		tif           TimeInForce
+		goodTillTime  *time.Time
+		goodTillBlock int64
+		valid         bool
+	}{
+		"good till time":                 {tif: TimeInForce_GoodTillTime, goodTillTime: &expiry, valid: true},
+		"good till block":                {tif: TimeInForce_GoodTillBlock, goodTillBlock: 10, valid: true},
+		"good till time without time":    {tif: TimeInForce_GoodTillTime},
+		"good till time with block":      {tif: TimeInForce_GoodTillTime, goodTillTime: &expiry, goodTillBlock: 10},
+		"good till block without block":  {tif: TimeInForce_GoodTillBlock},
+		"good till block with time":      {tif: TimeInForce_GoodTillBlock, goodTillTime: &expiry, goodTillBlock: 10},
+		"negative good till block":       {tif: TimeInForce_GoodTillBlock, goodTillBlock: -1},
+		"good till cancel with time":     {tif: TimeInForce_GoodTillCancel, goodTillTime: &expiry},
+		"immediate or cancel with block": {tif: TimeInForce_ImmediateOrCancel, goodTillBlock: 10},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewOrderWithExpiry(now, spec.tif, coin("100eur"), coin("120usd"), []byte("acc"), "A", spec.goodTillTime, spec.goodTillBlock)
+			if spec.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidExpiry)
+			}
+		})
+	}
+
+	o, err := NewOrderWithExpiry(now, TimeInForce_GoodTillTime, coin("100eur"), coin("120usd"), []byte("acc"), "A", &expiry, 0)
+	require.NoError(t, err)
+	require.False(t, o.IsExpired(expiry.Add(-time.Nanosecond), 100))
+	require.True(t, o.IsExpired(expiry, 1))
+
+	o, err = NewOrderWithExpiry(now, TimeInForce_GoodTillBlock, coin("100eur"), coin("120usd"), []byte("acc"), "A", nil, 10)
+	require.NoError(t, err)
+	require.False(t, o.IsExpired(expiry, 10))
+	require.True(t, o.IsExpired(now, 11))
+
+	o, err = NewOrder(now, TimeInForce_GoodTillCancel, coin("100eur"), coin("120usd"), []byte("acc"), "A")
+	require.NoError(t, err)
+	require.False(t, o.IsExpired(expiry, 100))
+}
+
 func coin(s string) sdk.Coin {
 	coin, err := sdk.ParseCoinNormalized(s)
 	if err != nil {